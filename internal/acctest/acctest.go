@@ -321,6 +321,10 @@ func PreCheck(ctx context.Context, t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
+		if IsEmulator() {
+			configureEmulator()
+		}
+
 		envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
 
 		if os.Getenv(envvar.AccessKeyId) != "" {
@@ -430,8 +434,14 @@ func CheckResourceAttrRegionalARNAccountID(resourceName, attributeName, arnServi
 // CheckResourceAttrRegionalHostnameService ensures the Terraform state exactly matches a service DNS hostname with region and partition DNS suffix
 //
 // For example: ec2.us-west-2.amazonaws.com
+//
+// In emulator mode only the presence of the attribute is checked.
 func CheckResourceAttrRegionalHostnameService(resourceName, attributeName, serviceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
+		if IsEmulator() {
+			return resource.TestCheckResourceAttrSet(resourceName, attributeName)(s)
+		}
+
 		hostname := fmt.Sprintf("%s.%s.%s", serviceName, Region(), PartitionDNSSuffix())
 
 		return resource.TestCheckResourceAttr(resourceName, attributeName, hostname)(s)
@@ -566,8 +576,14 @@ func MatchResourceAttrRegionalARNAccountID(resourceName, attributeName, arnServi
 }
 
 // MatchResourceAttrRegionalHostname ensures the Terraform state regexp matches a formatted DNS hostname with region and partition DNS suffix
+//
+// In emulator mode only the presence of the attribute is checked.
 func MatchResourceAttrRegionalHostname(resourceName, attributeName, serviceName string, hostnamePrefixRegexp *regexp.Regexp) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
+		if IsEmulator() {
+			return resource.TestCheckResourceAttrSet(resourceName, attributeName)(s)
+		}
+
 		hostnameRegexpPattern := fmt.Sprintf("%s\\.%s\\.%s\\.%s$", hostnamePrefixRegexp.String(), serviceName, Region(), PartitionDNSSuffix())
 
 		hostnameRegexp, err := regexp.Compile(hostnameRegexpPattern)
@@ -581,8 +597,14 @@ func MatchResourceAttrRegionalHostname(resourceName, attributeName, serviceName
 }
 
 // MatchResourceAttrGlobalHostname ensures the Terraform state regexp matches a formatted DNS hostname with partition DNS suffix and without region
+//
+// In emulator mode only the presence of the attribute is checked.
 func MatchResourceAttrGlobalHostname(resourceName, attributeName, serviceName string, hostnamePrefixRegexp *regexp.Regexp) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
+		if IsEmulator() {
+			return resource.TestCheckResourceAttrSet(resourceName, attributeName)(s)
+		}
+
 		hostnameRegexpPattern := fmt.Sprintf("%s\\.%s\\.%s$", hostnamePrefixRegexp.String(), serviceName, PartitionDNSSuffix())
 
 		hostnameRegexp, err := regexp.Compile(hostnameRegexpPattern)
@@ -1340,6 +1362,11 @@ func PreCheckRAMSharingWithOrganizationEnabledWithProvider(ctx context.Context,
 func PreCheckRegionOptIn(ctx context.Context, t *testing.T, region string) {
 	t.Helper()
 
+	// All Regions are enabled in an emulator.
+	if IsEmulator() {
+		return
+	}
+
 	output, err := tfaccount.FindRegionOptStatus(ctx, Provider.Meta().(*conns.AWSClient).AccountClient(ctx), "", region)
 
 	if err != nil {
@@ -1499,6 +1526,8 @@ func PreCheckDirectoryServiceSimpleDirectory(ctx context.Context, t *testing.T)
 func PreCheckOutpostsOutposts(ctx context.Context, t *testing.T) {
 	t.Helper()
 
+	SkipIfEmulator(t, "Outposts require on-premises hardware")
+
 	conn := Provider.Meta().(*conns.AWSClient).OutpostsClient(ctx)
 	input := outposts.ListOutpostsInput{}
 
@@ -1788,6 +1817,10 @@ func RegisterServiceErrorCheckFunc(serviceID string, f ServiceErrorCheckFunc) {
 func ErrorCheck(t *testing.T, serviceIDs ...string) resource.ErrorCheckFunc {
 	t.Helper()
 
+	if IsEmulator() && !emulatorSupportsServices(serviceIDs...) {
+		t.Skipf("skipping test in emulator mode: %s not supported (%s)", strings.Join(serviceIDs, ", "), envvar.EmulatorServices)
+	}
+
 	return func(err error) error {
 		if err == nil {
 			return nil
@@ -1808,6 +1841,10 @@ func ErrorCheck(t *testing.T, serviceIDs ...string) resource.ErrorCheckFunc {
 			t.Skipf("skipping test for %s/%s: %s", Partition(), Region(), err.Error())
 		}
 
+		if IsEmulator() && errorCheckEmulator(err) {
+			t.Skipf("skipping test in emulator mode: %s", err.Error())
+		}
+
 		return err
 	}
 }
@@ -1890,6 +1927,8 @@ func PreCheckSkipError(err error) bool {
 func PreCheckAssumeRoleARN(t *testing.T) {
 	t.Helper()
 
+	SkipIfEmulator(t, "IAM permissions are not enforced")
+
 	envvar.SkipIfEmpty(t, envvar.AccAssumeRoleARN, "Amazon Resource Name (ARN) of existing IAM Role to assume for testing restricted permissions")
 }
 
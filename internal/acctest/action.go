@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// InvokeAction invokes the specified action directly against a newly configured provider instance,
+// outside of a Terraform run, and returns the progress messages sent during the invocation.
+// Any attribute of the action's schema that is not present in config is set to null.
+// An error is returned if the invocation fails or completes with error diagnostics.
+func InvokeAction(ctx context.Context, t *testing.T, actionType string, config map[string]tftypes.Value) ([]string, error) {
+	t.Helper()
+
+	providerServer, err := ProtoV5ProviderFactories[ProviderName]()
+	if err != nil {
+		return nil, fmt.Errorf("creating provider server: %w", err)
+	}
+
+	p, ok := providerServer.(tfprotov5.ProviderServerWithActions) //nolint:staticcheck // SA1019: Working in alpha situation
+	if !ok {
+		return nil, errors.New("provider server does not support actions")
+	}
+
+	schemaResp, err := p.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("getting provider schema: %w", err)
+	}
+	if err := diagnosticsError(schemaResp.Diagnostics); err != nil {
+		return nil, fmt.Errorf("getting provider schema: %w", err)
+	}
+
+	actionSchema, ok := schemaResp.ActionSchemas[actionType]
+	if !ok {
+		return nil, fmt.Errorf("action %q not found in provider schema", actionType)
+	}
+
+	// Configure the provider from the environment, as for acceptance tests with an empty provider block.
+	providerConfig, err := nullFilledDynamicValue(schemaResp.Provider.ValueType(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("building provider configuration: %w", err)
+	}
+
+	configureResp, err := p.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		TerraformVersion: "1.14.0",
+		Config:           providerConfig,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("configuring provider: %w", err)
+	}
+	if err := diagnosticsError(configureResp.Diagnostics); err != nil {
+		return nil, fmt.Errorf("configuring provider: %w", err)
+	}
+
+	actionConfig, err := nullFilledDynamicValue(actionSchema.Schema.ValueType(), config)
+	if err != nil {
+		return nil, fmt.Errorf("building %s action configuration: %w", actionType, err)
+	}
+
+	invokeResp, err := p.InvokeAction(ctx, &tfprotov5.InvokeActionRequest{
+		ActionType: actionType,
+		Config:     actionConfig,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invoking %s action: %w", actionType, err)
+	}
+
+	var messages []string
+
+	for event := range invokeResp.Events {
+		switch v := event.Type.(type) {
+		case tfprotov5.ProgressInvokeActionEventType:
+			t.Logf("%s action progress: %s", actionType, v.Message)
+			messages = append(messages, v.Message)
+		case tfprotov5.CompletedInvokeActionEventType:
+			if err := diagnosticsError(v.Diagnostics); err != nil {
+				return messages, fmt.Errorf("invoking %s action: %w", actionType, err)
+			}
+		}
+	}
+
+	return messages, nil
+}
+
+// CheckActionProgress returns an error if the specified action progress messages do not contain
+// messages matching each of the expected regular expressions, in order.
+// Messages not matching any expected regular expression are ignored.
+func CheckActionProgress(messages []string, expected ...*regexp.Regexp) error {
+	i := 0
+	for _, message := range messages {
+		if i == len(expected) {
+			break
+		}
+		if expected[i].MatchString(message) {
+			i++
+		}
+	}
+
+	if i < len(expected) {
+		return fmt.Errorf("no action progress message matching %q (after %d matched), got: %q", expected[i], i, messages)
+	}
+
+	return nil
+}
+
+// nullFilledDynamicValue returns a DynamicValue of the specified object type with the specified attribute values.
+// Any attribute not present in values is set to null.
+func nullFilledDynamicValue(typ tftypes.Type, values map[string]tftypes.Value) (*tfprotov5.DynamicValue, error) {
+	objectType, ok := typ.(tftypes.Object)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %s, expected object", typ)
+	}
+
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attributes[name] = v
+		} else {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	for name := range values {
+		if _, ok := objectType.AttributeTypes[name]; !ok {
+			return nil, fmt.Errorf("unexpected attribute %q", name)
+		}
+	}
+
+	v, err := tfprotov5.NewDynamicValue(objectType, tftypes.NewValue(objectType, attributes))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// diagnosticsError returns an error combining any error diagnostics.
+func diagnosticsError(diags []*tfprotov5.Diagnostic) error {
+	var errs []error
+
+	for _, d := range diags {
+		if d != nil && d.Severity == tfprotov5.DiagnosticSeverityError {
+			errs = append(errs, fmt.Errorf("%s: %s", d.Summary, d.Detail))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCheckActionProgress(t *testing.T) {
+	t.Parallel()
+
+	messages := []string{
+		"Starting widget rotation for example...",
+		"Widget example is currently 'ROTATING', continuing to wait for completion...",
+		"Widget rotation for example completed successfully",
+	}
+
+	tests := []struct {
+		name      string
+		messages  []string
+		expected  []*regexp.Regexp
+		expectErr bool
+	}{
+		{
+			name:     "no expectations",
+			messages: messages,
+		},
+		{
+			name:     "all in order",
+			messages: messages,
+			expected: []*regexp.Regexp{
+				regexache.MustCompile(`^Starting `),
+				regexache.MustCompile(`continuing to wait`),
+				regexache.MustCompile(`completed successfully$`),
+			},
+		},
+		{
+			name:     "subset in order",
+			messages: messages,
+			expected: []*regexp.Regexp{
+				regexache.MustCompile(`^Starting `),
+				regexache.MustCompile(`completed successfully$`),
+			},
+		},
+		{
+			name:     "out of order",
+			messages: messages,
+			expected: []*regexp.Regexp{
+				regexache.MustCompile(`completed successfully$`),
+				regexache.MustCompile(`^Starting `),
+			},
+			expectErr: true,
+		},
+		{
+			name:     "no match",
+			messages: messages,
+			expected: []*regexp.Regexp{
+				regexache.MustCompile(`failed`),
+			},
+			expectErr: true,
+		},
+		{
+			name: "no messages",
+			expected: []*regexp.Regexp{
+				regexache.MustCompile(`^Starting `),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := acctest.CheckActionProgress(tt.messages, tt.expected...)
+
+			if got, want := err != nil, tt.expectErr; got != want {
+				t.Errorf("CheckActionProgress() error = %v, expectErr %t", err, want)
+			}
+		})
+	}
+}
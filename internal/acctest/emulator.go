@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+// Emulator mode runs acceptance tests against a local stand-in for AWS, such as
+// LocalStack or Moto, instead of a real AWS account.
+// It is enabled by setting TF_ACC_EMULATOR_ENDPOINT to the emulator's base URL.
+
+// IsEmulator indicates whether acceptance tests are running against a local AWS emulator.
+func IsEmulator() bool {
+	return os.Getenv(envvar.Emulator) != ""
+}
+
+// emulatorCredentials are the static credentials used in emulator mode if none are configured.
+// Emulators accept any credentials.
+const emulatorCredentials = "test"
+
+// configureEmulator points every service endpoint at the emulator and sets
+// placeholder credentials, as emulators don't authenticate requests.
+func configureEmulator() {
+	os.Setenv(envvar.EndpointURL, os.Getenv(envvar.Emulator))
+	os.Setenv(envvar.EC2MetadataDisabled, "true")
+
+	if os.Getenv(envvar.Profile) == "" && os.Getenv(envvar.AccessKeyId) == "" {
+		os.Setenv(envvar.AccessKeyId, emulatorCredentials)
+		os.Setenv(envvar.SecretAccessKey, emulatorCredentials)
+	}
+}
+
+// emulatorServiceIDs returns the service IDs of the service packages listed in TF_ACC_EMULATOR_SERVICES.
+var emulatorServiceIDs = sync.OnceValue(func() []string {
+	v := os.Getenv(envvar.EmulatorServices)
+	if v == "" {
+		return nil
+	}
+
+	var packages []string
+	for s := range strings.SplitSeq(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			packages = append(packages, s)
+		}
+	}
+
+	return serviceIDsForPackages(packages)
+})
+
+func serviceIDsForPackages(packages []string) []string {
+	serviceData, err := data.ReadAllServiceData()
+	if err != nil {
+		return nil
+	}
+
+	var serviceIDs []string
+	for _, sd := range serviceData {
+		if slices.Contains(packages, sd.ProviderPackage()) || slices.ContainsFunc(sd.Aliases(), func(alias string) bool { return slices.Contains(packages, alias) }) {
+			serviceIDs = append(serviceIDs, sd.SDKID())
+		}
+	}
+
+	return serviceIDs
+}
+
+// emulatorSupportsServices indicates whether the emulator supports all the specified services.
+func emulatorSupportsServices(serviceIDs ...string) bool {
+	supported := emulatorServiceIDs()
+	if supported == nil {
+		return true
+	}
+
+	for _, serviceID := range serviceIDs {
+		if !slices.Contains(supported, serviceID) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SkipIfEmulator skips a test that cannot run against a local AWS emulator, e.g. because
+// it depends on real AWS infrastructure or on an API the emulator doesn't implement.
+func SkipIfEmulator(t *testing.T, reason string) {
+	t.Helper()
+
+	if IsEmulator() {
+		t.Skipf("skipping test in emulator mode: %s", reason)
+	}
+}
+
+// errorCheckEmulator indicates whether an error is returned by an emulator for an unimplemented API.
+//
+// NOTE: This function cannot use the standard tfawserr helpers
+// as it is receiving error strings from the SDK testing framework,
+// not actual error types from the resource logic.
+func errorCheckEmulator(err error) bool {
+	if strings.Contains(err.Error(), "not yet implemented") {
+		return true
+	}
+
+	if strings.Contains(err.Error(), "NotImplemented") {
+		return true
+	}
+
+	if strings.Contains(err.Error(), "StatusCode: 501") {
+		return true
+	}
+
+	return false
+}
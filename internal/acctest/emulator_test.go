@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestServiceIDsForPackages(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		packages []string
+		want     []string
+	}{
+		"none": {},
+		"packages": {
+			packages: []string{"logs", "s3"},
+			want:     []string{names.LogsServiceID, names.S3ServiceID},
+		},
+		"alias": {
+			packages: []string{"cloudwatchlogs"},
+			want:     []string{names.LogsServiceID},
+		},
+		"unknown": {
+			packages: []string{"notaservice"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := acctest.ServiceIDsForPackages(testCase.packages)
+			slices.Sort(got)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestErrorCheckEmulator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"not implemented": {
+			err:  errors.New("operation error EC2: DescribeInstanceConnectEndpoints, https response error StatusCode: 501, api error InternalFailure: API action 'DescribeInstanceConnectEndpoints' for service 'ec2' not yet implemented or pro feature"),
+			want: true,
+		},
+		"NotImplemented": {
+			err:  errors.New("api error NotImplemented: The requested operation is not implemented"),
+			want: true,
+		},
+		"other": {
+			err: errors.New("api error ValidationException: 1 validation error detected"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := acctest.ErrorCheckEmulator(testCase.err), testCase.want; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/schemacmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+// CheckResourceSchemaCompatibility checks that the protocol-level schema of the Terraform Plugin Framework implementation
+// of the specified resource type, as served by the provider, is compatible with that of its Terraform Plugin SDKv2 implementation.
+// Every incompatible change, e.g. a dropped Computed flag, is reported as a test error.
+//
+// sdkv2Resource is the SDKv2 implementation, which is kept, without its @SDKResource annotation,
+// until the migration to the Terraform Plugin Framework is released.
+// The check does not make any AWS API calls and so does not require TF_ACC.
+func CheckResourceSchemaCompatibility(ctx context.Context, t *testing.T, typeName string, sdkv2Resource *schema.Resource) {
+	t.Helper()
+
+	providerServerFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
+	if err != nil {
+		t.Fatalf("creating provider server: %s", err)
+	}
+
+	if _, ok := primary.ResourcesMap[typeName]; ok {
+		t.Fatalf("%s is registered with its Terraform Plugin SDKv2 implementation; register its Terraform Plugin Framework implementation instead", typeName)
+	}
+
+	sdkv2Schema, err := schemacmp.SDKv2ResourceSchema(ctx, typeName, sdkv2Resource)
+	if err != nil {
+		t.Fatalf("rendering %s Terraform Plugin SDKv2 schema: %s", typeName, err)
+	}
+
+	frameworkSchema, err := schemacmp.ResourceSchema(ctx, providerServerFactory(), typeName)
+	if err != nil {
+		t.Fatalf("rendering %s Terraform Plugin Framework schema: %s", typeName, err)
+	}
+
+	for _, v := range schemacmp.Compare(sdkv2Schema, frameworkSchema) {
+		t.Errorf("%s Terraform Plugin Framework schema is incompatible with its Terraform Plugin SDKv2 schema: %s", typeName, v)
+	}
+}
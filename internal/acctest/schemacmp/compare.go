@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemacmp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Compare returns the changes from schema x to schema y that are incompatible with
+// configuration or state written using schema x, e.g. a changed attribute type or a dropped Computed flag.
+// Changes that relax a schema, e.g. a Required attribute becoming Optional, are compatible.
+// The MinItems and MaxItems of nested blocks are only compared if set in y, as
+// Plugin Framework blocks are limited by validators rather than by the schema.
+func Compare(x, y *Schema) []string {
+	var diffs []string
+
+	if y.Version < x.Version {
+		diffs = append(diffs, fmt.Sprintf("schema version decreased from %d to %d", x.Version, y.Version))
+	}
+
+	diffs = append(diffs, compareBlocks("", x.Block, y.Block)...)
+
+	return diffs
+}
+
+func compareBlocks(path string, x, y *Block) []string {
+	var diffs []string
+
+	if x == nil {
+		x = &Block{}
+	}
+	if y == nil {
+		y = &Block{}
+	}
+
+	for _, name := range sortedKeys(x.Attributes) {
+		xa, p := x.Attributes[name], join(path, name)
+
+		ya, ok := y.Attributes[name]
+		if !ok {
+			if _, ok := y.BlockTypes[name]; ok {
+				diffs = append(diffs, fmt.Sprintf("%s: changed from an attribute to a block", p))
+			} else {
+				diffs = append(diffs, fmt.Sprintf("%s: attribute removed", p))
+			}
+			continue
+		}
+
+		if !typesEqual(xa.Type, ya.Type) {
+			diffs = append(diffs, fmt.Sprintf("%s: type changed from %s to %s", p, compact(xa.Type), compact(ya.Type)))
+		}
+		if !xa.Required && ya.Required {
+			diffs = append(diffs, fmt.Sprintf("%s: Required added", p))
+		}
+		// Plugin SDKv2 adds an Optional id attribute to every resource. It is never set in configuration.
+		if (xa.Optional || xa.Required) && !(ya.Optional || ya.Required) && p != names.AttrID {
+			diffs = append(diffs, fmt.Sprintf("%s: Optional removed", p))
+		}
+		if xa.Computed && !ya.Computed {
+			diffs = append(diffs, fmt.Sprintf("%s: Computed removed", p))
+		}
+		if xa.Sensitive && !ya.Sensitive {
+			diffs = append(diffs, fmt.Sprintf("%s: Sensitive removed", p))
+		}
+	}
+
+	for _, name := range sortedKeys(y.Attributes) {
+		if _, ok := x.Attributes[name]; ok {
+			continue
+		}
+		if _, ok := x.BlockTypes[name]; ok {
+			continue
+		}
+
+		if y.Attributes[name].Required {
+			diffs = append(diffs, fmt.Sprintf("%s: Required attribute added", join(path, name)))
+		}
+	}
+
+	for _, name := range sortedKeys(x.BlockTypes) {
+		xb, p := x.BlockTypes[name], join(path, name)
+
+		yb, ok := y.BlockTypes[name]
+		if !ok {
+			if _, ok := y.Attributes[name]; ok {
+				diffs = append(diffs, fmt.Sprintf("%s: changed from a block to an attribute", p))
+			} else {
+				diffs = append(diffs, fmt.Sprintf("%s: block removed", p))
+			}
+			continue
+		}
+
+		if xb.NestingMode != yb.NestingMode {
+			diffs = append(diffs, fmt.Sprintf("%s: nesting mode changed from %s to %s", p, xb.NestingMode, yb.NestingMode))
+		}
+		if yb.MinItems > xb.MinItems {
+			diffs = append(diffs, fmt.Sprintf("%s: MinItems increased from %d to %d", p, xb.MinItems, yb.MinItems))
+		}
+		if yb.MaxItems > 0 && (xb.MaxItems == 0 || yb.MaxItems < xb.MaxItems) {
+			diffs = append(diffs, fmt.Sprintf("%s: MaxItems decreased from %d to %d", p, xb.MaxItems, yb.MaxItems))
+		}
+
+		diffs = append(diffs, compareBlocks(p, xb.Block, yb.Block)...)
+	}
+
+	for _, name := range sortedKeys(y.BlockTypes) {
+		if _, ok := x.BlockTypes[name]; ok {
+			continue
+		}
+		if _, ok := x.Attributes[name]; ok {
+			continue
+		}
+
+		if y.BlockTypes[name].MinItems > 0 {
+			diffs = append(diffs, fmt.Sprintf("%s: required block added", join(path, name)))
+		}
+	}
+
+	return diffs
+}
+
+// typesEqual compares the JSON representations of types. Object attribute types are always sorted by name.
+func typesEqual(x, y json.RawMessage) bool {
+	return bytes.Equal(compact(x), compact(y))
+}
+
+func compact(v json.RawMessage) json.RawMessage {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, v); err != nil {
+		return v
+	}
+	return buf.Bytes()
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return strings.Join([]string{path, name}, ".")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
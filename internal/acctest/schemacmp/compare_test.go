@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemacmp_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/schemacmp"
+)
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	// A resource as rendered by Plugin SDKv2.
+	x := &tfprotov5.Schema{
+		Version: 1,
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{
+				{Name: "arn", Type: tftypes.String, Computed: true},
+				{Name: "id", Type: tftypes.String, Optional: true, Computed: true},
+				{Name: "aliases", Type: tftypes.List{ElementType: tftypes.String}, Optional: true},
+				{Name: "password", Type: tftypes.String, Optional: true, Sensitive: true},
+				{Name: "status", Type: tftypes.String, Optional: true, Computed: true},
+			},
+			BlockTypes: []*tfprotov5.SchemaNestedBlock{
+				{
+					TypeName: "rule",
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+					MaxItems: 1,
+					Block: &tfprotov5.SchemaBlock{
+						Attributes: []*tfprotov5.SchemaAttribute{
+							{Name: "size", Type: tftypes.Number, Required: true},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		testName string
+		y        *tfprotov5.Schema
+		want     []string
+	}{
+		{
+			testName: "compatible",
+			// A resource as rendered by the Plugin Framework.
+			y: &tfprotov5.Schema{
+				Version: 2,
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{Name: "arn", Type: tftypes.String, Computed: true},
+						{Name: "id", Type: tftypes.String, Computed: true},
+						{Name: "aliases", Type: tftypes.List{ElementType: tftypes.String}, Optional: true},
+						{Name: "password", Type: tftypes.String, Optional: true, Sensitive: true},
+						{Name: "region", Type: tftypes.String, Optional: true, Computed: true},
+						{Name: "status", Type: tftypes.String, Optional: true, Computed: true},
+					},
+					BlockTypes: []*tfprotov5.SchemaNestedBlock{
+						{
+							TypeName: "rule",
+							Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+							Block: &tfprotov5.SchemaBlock{
+								Attributes: []*tfprotov5.SchemaAttribute{
+									{Name: "size", Type: tftypes.Number, Optional: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			testName: "incompatible",
+			y: &tfprotov5.Schema{
+				Version: 0,
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{Name: "arn", Type: tftypes.String, Optional: true},
+						{Name: "id", Type: tftypes.String, Computed: true},
+						{Name: "aliases", Type: tftypes.Set{ElementType: tftypes.String}, Required: true},
+						{Name: "password", Type: tftypes.String, Optional: true},
+						{Name: "rule", Type: tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"size": tftypes.Number}}}, Optional: true},
+						{Name: "type", Type: tftypes.String, Required: true},
+					},
+					BlockTypes: []*tfprotov5.SchemaNestedBlock{
+						{
+							TypeName: "status",
+							Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+							Block:    &tfprotov5.SchemaBlock{},
+						},
+					},
+				},
+			},
+			want: []string{
+				"schema version decreased from 1 to 0",
+				`aliases: type changed from ["list","string"] to ["set","string"]`,
+				"aliases: Required added",
+				"arn: Computed removed",
+				"password: Sensitive removed",
+				"status: changed from an attribute to a block",
+				"type: Required attribute added",
+				"rule: changed from a block to an attribute",
+			},
+		},
+		{
+			testName: "nested block",
+			y: &tfprotov5.Schema{
+				Version: 1,
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{Name: "arn", Type: tftypes.String, Computed: true},
+						{Name: "id", Type: tftypes.String, Optional: true, Computed: true},
+						{Name: "aliases", Type: tftypes.List{ElementType: tftypes.String}, Optional: true},
+						{Name: "password", Type: tftypes.String, Optional: true, Sensitive: true},
+						{Name: "status", Type: tftypes.String, Computed: true},
+					},
+					BlockTypes: []*tfprotov5.SchemaNestedBlock{
+						{
+							TypeName: "rule",
+							Nesting:  tfprotov5.SchemaNestedBlockNestingModeSet,
+							MinItems: 1,
+							MaxItems: 1,
+							Block: &tfprotov5.SchemaBlock{
+								Attributes: []*tfprotov5.SchemaAttribute{
+									{Name: "size", Type: tftypes.String, Required: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []string{
+				"status: Optional removed",
+				"rule: nesting mode changed from list to set",
+				"rule: MinItems increased from 0 to 1",
+				"rule.size: type changed from \"number\" to \"string\"",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			x, err := schemacmp.FromProto(x)
+			if err != nil {
+				t.Fatal(err)
+			}
+			y, err := schemacmp.FromProto(testCase.y)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(schemacmp.Compare(x, y), testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemacmp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Schema is the protocol-level schema of a resource.
+// Its JSON encoding matches that of `terraform providers schema -json`, without descriptions.
+type Schema struct {
+	Version int64  `json:"version"`
+	Block   *Block `json:"block"`
+}
+
+type Block struct {
+	Attributes map[string]*Attribute   `json:"attributes,omitempty"`
+	BlockTypes map[string]*NestedBlock `json:"block_types,omitempty"`
+}
+
+type Attribute struct {
+	Type      json.RawMessage `json:"type"`
+	Required  bool            `json:"required,omitempty"`
+	Optional  bool            `json:"optional,omitempty"`
+	Computed  bool            `json:"computed,omitempty"`
+	Sensitive bool            `json:"sensitive,omitempty"`
+}
+
+type NestedBlock struct {
+	NestingMode string `json:"nesting_mode"`
+	Block       *Block `json:"block"`
+	MinItems    int64  `json:"min_items,omitempty"`
+	MaxItems    int64  `json:"max_items,omitempty"`
+}
+
+// SDKv2ResourceSchema returns the protocol-level schema of the specified Terraform Plugin SDKv2 resource.
+func SDKv2ResourceSchema(ctx context.Context, typeName string, r *sdkschema.Resource) (*Schema, error) {
+	p := &sdkschema.Provider{
+		ResourcesMap: map[string]*sdkschema.Resource{
+			typeName: r,
+		},
+	}
+
+	return ResourceSchema(ctx, sdkschema.NewGRPCProviderServer(p), typeName)
+}
+
+// ResourceSchema returns the protocol-level schema of the specified resource type.
+func ResourceSchema(ctx context.Context, server tfprotov5.ProviderServer, typeName string) (*Schema, error) {
+	response, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("getting provider schema: %w", err)
+	}
+
+	for _, v := range response.Diagnostics {
+		if v.Severity == tfprotov5.DiagnosticSeverityError {
+			return nil, fmt.Errorf("getting provider schema: %s: %s", v.Summary, v.Detail)
+		}
+	}
+
+	schema, ok := response.ResourceSchemas[typeName]
+	if !ok {
+		return nil, fmt.Errorf("resource %q not found in provider schema", typeName)
+	}
+
+	return FromProto(schema)
+}
+
+// FromProto converts a terraform-plugin-go protocol v5 schema.
+func FromProto(schema *tfprotov5.Schema) (*Schema, error) {
+	block, err := fromProtoBlock(schema.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Schema{
+		Version: schema.Version,
+		Block:   block,
+	}, nil
+}
+
+func fromProtoBlock(block *tfprotov5.SchemaBlock) (*Block, error) {
+	if block == nil {
+		return &Block{}, nil
+	}
+
+	apiObject := &Block{}
+
+	for _, v := range block.Attributes {
+		typ, err := v.Type.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("attribute %q: %w", v.Name, err)
+		}
+
+		if apiObject.Attributes == nil {
+			apiObject.Attributes = make(map[string]*Attribute)
+		}
+		apiObject.Attributes[v.Name] = &Attribute{
+			Type:      typ,
+			Required:  v.Required,
+			Optional:  v.Optional,
+			Computed:  v.Computed,
+			Sensitive: v.Sensitive,
+		}
+	}
+
+	for _, v := range block.BlockTypes {
+		nested, err := fromProtoBlock(v.Block)
+		if err != nil {
+			return nil, fmt.Errorf("block %q: %w", v.TypeName, err)
+		}
+
+		if apiObject.BlockTypes == nil {
+			apiObject.BlockTypes = make(map[string]*NestedBlock)
+		}
+		apiObject.BlockTypes[v.TypeName] = &NestedBlock{
+			NestingMode: strings.ToLower(v.Nesting.String()),
+			Block:       nested,
+			MinItems:    v.MinItems,
+			MaxItems:    v.MaxItems,
+		}
+	}
+
+	return apiObject, nil
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemacmp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/schemacmp"
+)
+
+func TestSDKv2ResourceSchema(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+
+	r := &schema.Resource{
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"size": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	got, err := schemacmp.SDKv2ResourceSchema(ctx, "aws_example_widget", r)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := &schemacmp.Schema{
+		Version: 1,
+		Block: &schemacmp.Block{
+			Attributes: map[string]*schemacmp.Attribute{
+				"arn":      {Type: json.RawMessage(`"string"`), Computed: true},
+				"id":       {Type: json.RawMessage(`"string"`), Optional: true, Computed: true},
+				"password": {Type: json.RawMessage(`"string"`), Optional: true, Sensitive: true},
+			},
+			BlockTypes: map[string]*schemacmp.NestedBlock{
+				"rule": {
+					NestingMode: "list",
+					MinItems:    1,
+					MaxItems:    1,
+					Block: &schemacmp.Block{
+						Attributes: map[string]*schemacmp.Attribute{
+							"size": {Type: json.RawMessage(`"number"`), Required: true},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -27,8 +27,10 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"math/rand" // nosemgrep: go.lang.security.audit.crypto.math_random.math-random-used -- Deterministic PRNG required for VCR test reproducibility
 	"net/http"
 	"os"
@@ -224,13 +226,14 @@ func vcrSensitiveHeaderHook(i *cassette.Interaction) error {
 }
 
 // vcrMatcherFunc defines how VCR will match requests to stored interactions.
-func vcrMatcherFunc(ctx context.Context) recorder.MatcherFunc {
+// The request is redacted before being compared with the stored, redacted, interaction.
+func vcrMatcherFunc(ctx context.Context, redactor *vcr.Redactor) recorder.MatcherFunc {
 	return func(r *http.Request, i cassette.Request) bool {
 		if r.Method != i.Method {
 			return false
 		}
 
-		if r.URL.String() != i.URL {
+		if redactor.Redact(r.URL.String()) != i.URL {
 			return false
 		}
 
@@ -246,8 +249,8 @@ func vcrMatcherFunc(ctx context.Context) recorder.MatcherFunc {
 			return false
 		}
 
-		r.Body = io.NopCloser(&b)
-		body := b.String()
+		r.Body = io.NopCloser(bytes.NewReader(b.Bytes()))
+		body := redactor.Redact(b.String())
 		// If body matches identically, we are done.
 		if body == i.Body {
 			return true
@@ -302,18 +305,42 @@ func vcrHTTPClient(ctx context.Context, testName string) (*http.Client, error) {
 
 	cassetteName := filepath.Join(vcr.Path(), vcrFileName(testName))
 
-	// Create a VCR recorder around a default HTTP client.
-	r, err := recorder.New(cassetteName,
+	// Sensitive values are learned as interactions are captured and redacted when the cassette is saved,
+	// so that a value identified in any interaction is redacted in all of them.
+	redactor, err := vcr.NewRedactorFromEnv()
+	if err != nil {
+		return httpClient, err
+	}
+
+	opts := []recorder.Option{
 		recorder.WithHook(vcrSensitiveHeaderHook, recorder.AfterCaptureHook),
-		recorder.WithMatcher(vcrMatcherFunc(ctx)),
+		recorder.WithHook(redactor.Learn, recorder.AfterCaptureHook),
+		recorder.WithHook(redactor.RedactInteraction, recorder.BeforeSaveHook),
+		recorder.WithHook(redactor.RestoreResponse, recorder.BeforeResponseReplayHook),
+		recorder.WithMatcher(vcrMatcherFunc(ctx, redactor)),
 		recorder.WithMode(vcrMode),
 		recorder.WithRealTransport(httpClient.Transport),
 		recorder.WithSkipRequestLatency(true),
-	)
+	}
+
+	var verifier *vcr.Verifier
+	if vcr.IsVerify() {
+		verifier = new(vcr.Verifier)
+		opts = append(opts, recorder.WithHook(verifier.UnusedInteractionHook, recorder.OnRecorderStopHook))
+	}
+
+	// Create a VCR recorder around a default HTTP client.
+	r, err := recorder.New(cassetteName, opts...)
 	if err != nil {
 		return httpClient, err
 	}
 
+	if verifier != nil {
+		verifier.Recorder = r
+		httpClient.Transport = verifier
+		return httpClient, nil
+	}
+
 	httpClient.Transport = r
 	return httpClient, nil
 }
@@ -322,7 +349,8 @@ func vcrHTTPClient(ctx context.Context, testName string) (*http.Client, error) {
 //
 // In RECORD_ONLY mode, generates a new seed to use as a source. This seed is
 // saved to a file when the recorder is closed.
-// In REPLAY_ONLY mode, reads a seed from a file and creates a source from it.
+// In REPLAY_ONLY and VERIFY modes, reads a seed from a file and creates a source from it.
+// In RECORD_MISSING mode, reads a seed from a file if one exists, otherwise generates a new seed.
 func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 	t.Helper()
 	testName := t.Name()
@@ -343,6 +371,19 @@ func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 	switch vcrMode {
 	case recorder.ModeRecordOnly:
 		seed := rand.Int63()
+		s = &randomnessSource{
+			seed:   seed,
+			source: rand.NewSource(seed),
+		}
+	case recorder.ModeReplayWithNewEpisodes:
+		seed, err := readSeedFromFile(vcrSeedFile(vcr.Path(), testName))
+		if err != nil {
+			if !errors.Is(err, fs.ErrNotExist) {
+				return nil, err
+			}
+			seed = rand.Int63()
+		}
+
 		s = &randomnessSource{
 			seed:   seed,
 			source: rand.NewSource(seed),
@@ -385,12 +426,18 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	providerMetas.Unlock()
 
 	if ok {
-		if !t.Failed() {
-			if v, ok := store.meta.HTTPClient(ctx).Transport.(*recorder.Recorder); ok {
+		switch v := store.meta.HTTPClient(ctx).Transport.(type) {
+		case *recorder.Recorder:
+			if !t.Failed() {
 				if err := v.Stop(); err != nil {
 					t.Error(err)
 				}
 			}
+		case *vcr.Verifier:
+			// Report drift even if the test failed, e.g. because a request no longer matches.
+			if err := v.Stop(); err != nil {
+				t.Error(err)
+			}
 		}
 
 		providerMetas.Lock()
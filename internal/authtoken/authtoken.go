@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package authtoken generates the SigV4 presigned URL authentication tokens
+// used as database passwords by services such as Amazon RDS and Aurora DSQL.
+package authtoken
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+// emptyPayloadHash is the hex-encoded SHA-256 hash of an empty request body.
+const emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// Build returns an authentication token for endpoint (host or host:port), signed for the specified service and Region.
+// The token is a SigV4 presigned GET URL, without the scheme, with the specified query parameters and lifetime.
+// It is generated locally; no AWS API is called.
+func Build(ctx context.Context, endpoint, service, region string, query url.Values, expiresIn time.Duration, credentials aws.CredentialsProvider, signingTime time.Time) (string, error) {
+	if credentials == nil {
+		return "", errors.New("no AWS credentials configured")
+	}
+
+	creds, err := credentials.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("retrieving AWS credentials: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	query = maps.Clone(query)
+	if query == nil {
+		query = make(url.Values)
+	}
+	query.Set("X-Amz-Expires", strconv.Itoa(int(expiresIn.Seconds())))
+	req.URL.RawQuery = query.Encode()
+
+	signedURI, _, err := v4.NewSigner().PresignHTTP(ctx, creds, req, emptyPayloadHash, service, region, signingTime)
+	if err != nil {
+		return "", fmt.Errorf("signing %s authentication token: %w", service, err)
+	}
+
+	return strings.TrimPrefix(signedURI, "https://"), nil
+}
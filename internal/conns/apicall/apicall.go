@@ -2,10 +2,13 @@
 // SPDX-License-Identifier: MPL-2.0
 
 // Package apicall captures AWS SDK for Go v2 operation invocations made
-// through the provider's service clients, for use in tests.
+// through the provider's service clients, for use in tests and in the
+// provider-level API call report (see Report).
 //
-// The Smithy middleware is opt-in per request: when no Recorder is attached
-// to the operation context (see NewContext), it is a no-op.
+// The Smithy middleware is opt-in per request: when neither a Recorder nor a
+// Summary is attached to the operation context (see NewContext and
+// NewSummaryContext), it is a no-op. A Recorder retains every call; a Summary
+// only keeps per-operation counts and a bounded latency sample.
 //
 // The middleware runs at the end of Initialize, after RegisterServiceMetadata
 // populates ServiceID and OperationName, and captures the final post-retry
@@ -16,7 +19,9 @@
 // (TracerProvider / MeterProvider) wired via aws.Config.ServiceOptions.
 // smithyoteltracing.Adapt and smithyotelmetrics.Adapt bridge those to a
 // real OTEL SDK. This package's recorder is intentionally limited to the
-// "did this operation happen" assertion use case.
+// "did this operation happen" assertion use case and to the coarse
+// per-resource summary written when the provider's api_call_report
+// argument is set.
 package apicall
 
 import (
@@ -25,7 +30,9 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/smithy-go/middleware"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 )
@@ -38,6 +45,9 @@ type Call struct {
 	At        time.Time     // Time of recording (after the call returned).
 	Duration  time.Duration // Wall-clock time spent in the SDK stack, including retries.
 	RequestID string        // AWS request ID from the response, when available.
+	Resource  string        // Terraform type name that made the call, e.g. "aws_iam_role", when known.
+	Attempts  int           // Number of HTTP attempts made, including the first. Zero when unknown.
+	Throttles int           // Number of attempts that failed with a throttling error.
 }
 
 // Cursor is an opaque position into a Recorder's call log. Use Mark to obtain
@@ -165,11 +175,52 @@ func FromContext(ctx context.Context) (*Recorder, bool) {
 	return r, r != nil
 }
 
+// summaryKey is the typed context key under which a *Summary is stored.
+var summaryKey = inttypes.NewContextKey[*Summary]()
+
+// NewSummaryContext returns ctx with s attached. The middleware counts
+// against s any operation whose context descends from the returned context.
+//
+// A nil s returns ctx unchanged.
+func NewSummaryContext(ctx context.Context, s *Summary) context.Context {
+	if s == nil {
+		return ctx
+	}
+	return summaryKey.NewContext(ctx, s)
+}
+
+// SummaryFromContext extracts the Summary attached to ctx, if any.
+func SummaryFromContext(ctx context.Context) (*Summary, bool) {
+	s := summaryKey.FromContext(ctx)
+	return s, s != nil
+}
+
+// resourceKey is the typed context key under which the calling Terraform
+// type name is stored.
+var resourceKey = inttypes.NewContextKey[string]()
+
+// NewResourceContext returns ctx labelled with the Terraform type name
+// (e.g. "aws_iam_role") on whose behalf subsequent operations are made.
+// The label is copied into Call.Resource.
+//
+// An empty typeName returns ctx unchanged.
+func NewResourceContext(ctx context.Context, typeName string) context.Context {
+	if typeName == "" {
+		return ctx
+	}
+	return resourceKey.NewContext(ctx, typeName)
+}
+
+// ResourceFromContext returns the Terraform type name attached to ctx, if any.
+func ResourceFromContext(ctx context.Context) string {
+	return resourceKey.FromContext(ctx)
+}
+
 // MiddlewareID is the Smithy stack identifier of the recording middleware.
 const MiddlewareID = "TerraformProviderAWSCallRecorder"
 
-// recorderMiddleware records each operation against the Recorder attached
-// to its context. Runs at Initialize.After: after RegisterServiceMetadata
+// recorderMiddleware records each operation against the Recorder and the
+// Summary attached to its context. Runs at Initialize.After: after RegisterServiceMetadata
 // populates ctx, and after the rest of the stack returns the final error.
 type recorderMiddleware struct{}
 
@@ -183,25 +234,55 @@ func (recorderMiddleware) HandleInitialize(
 	start := time.Now()
 	out, metadata, err := next.HandleInitialize(ctx, in)
 
-	if rec, ok := FromContext(ctx); ok {
+	rec, recOK := FromContext(ctx)
+	summary, summaryOK := SummaryFromContext(ctx)
+	if recOK || summaryOK {
 		end := time.Now()
 		reqID, _ := awsmiddleware.GetRequestIDMetadata(metadata)
-		rec.RecordCall(Call{
+		attempts, throttles := attemptCounts(metadata)
+		c := Call{
 			Service:   awsmiddleware.GetServiceID(ctx),
 			Operation: awsmiddleware.GetOperationName(ctx),
 			Err:       err,
 			At:        end,
 			Duration:  end.Sub(start),
 			RequestID: reqID,
-		})
+			Resource:  ResourceFromContext(ctx),
+			Attempts:  attempts,
+			Throttles: throttles,
+		}
+		if recOK {
+			rec.RecordCall(c)
+		}
+		if summaryOK {
+			summary.Add(c)
+		}
 	}
 
 	return out, metadata, err
 }
 
+// attemptCounts returns the number of attempts made by the retryer and how
+// many of them failed with a throttling error.
+func attemptCounts(metadata middleware.Metadata) (int, int) {
+	results, ok := retry.GetAttemptResults(metadata)
+	if !ok {
+		return 0, 0
+	}
+
+	var throttles int
+	for _, v := range results.Results {
+		if v.Err != nil && retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(v.Err) == aws.TrueTernary {
+			throttles++
+		}
+	}
+
+	return len(results.Results), throttles
+}
+
 // Middleware returns a stack mutator that registers the recording middleware
 // on a Smithy stack. Idempotent. Append once to aws.Config.APIOptions; the
-// middleware gates itself on a Recorder in the request context.
+// middleware gates itself on a Recorder or Summary in the request context.
 func Middleware() func(*middleware.Stack) error {
 	return func(stack *middleware.Stack) error {
 		if _, ok := stack.Initialize.Get(MiddlewareID); ok {
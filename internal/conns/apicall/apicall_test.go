@@ -265,6 +265,72 @@ func TestMiddleware_RecordsErrorFromInner(t *testing.T) {
 	}
 }
 
+func TestMiddleware_RecordsResource(t *testing.T) {
+	t.Parallel()
+
+	r := NewRecorder()
+	ctx := NewResourceContext(NewContext(context.Background(), r), "aws_iam_role")
+
+	stack := middleware.NewStack("test", smithyRequestBuilder)
+	if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+		ServiceID:     "IAM",
+		OperationName: "GetRole",
+	}, middleware.Before); err != nil {
+		t.Fatalf("adding RegisterServiceMetadata: %v", err)
+	}
+	if err := Middleware()(stack); err != nil {
+		t.Fatalf("adding recorder middleware: %v", err)
+	}
+
+	if _, _, err := middleware.DecorateHandler(noopHandler{}, stack).Handle(ctx, nil); err != nil {
+		t.Fatalf("stack.Handle: %v", err)
+	}
+
+	calls := r.Calls()
+	if len(calls) != 1 {
+		t.Fatalf("len(Calls()) = %d, want 1", len(calls))
+	}
+	if got, want := calls[0].Resource, "aws_iam_role"; got != want {
+		t.Errorf("Resource = %q, want %q", got, want)
+	}
+	// No retry middleware in the stack, so no attempt metadata.
+	if got, want := calls[0].Attempts, 0; got != want {
+		t.Errorf("Attempts = %d, want %d", got, want)
+	}
+}
+
+func TestMiddleware_RecordsSummary(t *testing.T) {
+	t.Parallel()
+
+	s := NewSummary()
+	ctx := NewResourceContext(NewSummaryContext(context.Background(), s), "aws_iam_role")
+
+	stack := middleware.NewStack("test", smithyRequestBuilder)
+	if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+		ServiceID:     "IAM",
+		OperationName: "GetRole",
+	}, middleware.Before); err != nil {
+		t.Fatalf("adding RegisterServiceMetadata: %v", err)
+	}
+	if err := Middleware()(stack); err != nil {
+		t.Fatalf("adding recorder middleware: %v", err)
+	}
+
+	for range 2 {
+		if _, _, err := middleware.DecorateHandler(noopHandler{}, stack).Handle(ctx, nil); err != nil {
+			t.Fatalf("stack.Handle: %v", err)
+		}
+	}
+
+	report := s.Report()
+	if got, want := len(report.Entries), 1; got != want {
+		t.Fatalf("len(Entries) = %d, want %d", got, want)
+	}
+	if e := report.Entries[0]; e.Resource != "aws_iam_role" || e.Service != "IAM" || e.Operation != "GetRole" || e.Calls != 2 {
+		t.Errorf("Entries[0] = %+v", e)
+	}
+}
+
 func TestMiddleware_NoRecorderInContextIsNoop(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,195 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package apicall
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxLatencySamples bounds the number of call durations kept per report entry.
+// Beyond it, samples are replaced at random (reservoir sampling), so that
+// memory use does not grow with the number of calls and the reported
+// percentiles remain unbiased estimates.
+const maxLatencySamples = 1024
+
+// Report summarizes recorded calls per resource type, service and operation.
+// It is the document written to the file named by the provider's
+// api_call_report argument.
+type Report struct {
+	GeneratedAt time.Time     `json:"generated_at"`
+	ProcessID   int           `json:"process_id,omitempty"`
+	TotalCalls  int           `json:"total_calls"`
+	Entries     []ReportEntry `json:"entries"`
+}
+
+// ReportEntry is the summary of the calls to one operation made on behalf of
+// one Terraform resource type.
+type ReportEntry struct {
+	Resource  string  `json:"resource,omitempty"` // Empty for calls made outside any resource, e.g. during provider configuration.
+	Service   string  `json:"service"`
+	Operation string  `json:"operation"`
+	Calls     int     `json:"calls"`
+	Errors    int     `json:"errors"`
+	Retries   int     `json:"retries"`
+	Throttles int     `json:"throttles"`
+	P50Millis float64 `json:"p50_ms"`
+	P95Millis float64 `json:"p95_ms"`
+}
+
+type reportKey struct {
+	resource, service, operation string
+}
+
+type summaryEntry struct {
+	ReportEntry
+	durations []time.Duration // At most maxLatencySamples.
+}
+
+// Summary aggregates calls per resource type, service and operation as they
+// are made, without retaining the calls themselves. It is the in-memory form
+// of a Report. Construct via NewSummary. Safe for concurrent use.
+type Summary struct {
+	mu      sync.Mutex
+	total   int
+	entries map[reportKey]*summaryEntry
+}
+
+// NewSummary returns an empty Summary.
+func NewSummary() *Summary {
+	return &Summary{
+		entries: make(map[reportKey]*summaryEntry),
+	}
+}
+
+// Add counts c.
+func (s *Summary) Add(c Call) {
+	k := reportKey{resource: c.Resource, service: c.Service, operation: c.Operation}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.total++
+
+	e, ok := s.entries[k]
+	if !ok {
+		e = &summaryEntry{
+			ReportEntry: ReportEntry{
+				Resource:  c.Resource,
+				Service:   c.Service,
+				Operation: c.Operation,
+			},
+		}
+		s.entries[k] = e
+	}
+
+	e.Calls++
+	if c.Err != nil {
+		e.Errors++
+	}
+	if c.Attempts > 1 {
+		e.Retries += c.Attempts - 1
+	}
+	e.Throttles += c.Throttles
+
+	if len(e.durations) < maxLatencySamples {
+		e.durations = append(e.durations, c.Duration)
+	} else if i := rand.IntN(e.Calls); i < maxLatencySamples { // #nosec G404 // Sampling, not security.
+		e.durations[i] = c.Duration
+	}
+}
+
+// NewReport aggregates calls into a Report.
+func NewReport(calls []Call) *Report {
+	s := NewSummary()
+	for _, c := range calls {
+		s.Add(c)
+	}
+
+	return s.Report()
+}
+
+// Report returns a Report of the calls counted so far.
+//
+// Entries are ordered by descending call count so that the resources driving
+// the most API traffic come first; ties are broken by resource, service and
+// operation name to keep the output stable.
+func (s *Summary) Report() *Report {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	report := &Report{
+		GeneratedAt: time.Now().UTC(),
+		TotalCalls:  s.total,
+		Entries:     make([]ReportEntry, 0, len(s.entries)),
+	}
+	for _, e := range s.entries {
+		d := slices.Clone(e.durations)
+		slices.Sort(d)
+		entry := e.ReportEntry
+		entry.P50Millis = millis(percentile(d, 50))
+		entry.P95Millis = millis(percentile(d, 95))
+		report.Entries = append(report.Entries, entry)
+	}
+
+	slices.SortFunc(report.Entries, func(a, b ReportEntry) int {
+		return cmp.Or(
+			cmp.Compare(b.Calls, a.Calls),
+			cmp.Compare(a.Resource, b.Resource),
+			cmp.Compare(a.Service, b.Service),
+			cmp.Compare(a.Operation, b.Operation),
+		)
+	})
+
+	return report
+}
+
+// WriteFile writes the report as indented JSON to path, replacing any
+// existing file.
+func (r *Report) WriteFile(path string) error {
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling API call report: %w", err)
+	}
+
+	if err := os.WriteFile(path, b, 0o600); err != nil {
+		return fmt.Errorf("writing API call report (%s): %w", path, err)
+	}
+
+	return nil
+}
+
+// ProcessReportPath returns the name of the report file written by the process
+// with the specified ID, formed by inserting the process ID before the
+// extension of path, e.g. "api-calls.json" becomes "api-calls.1234.json".
+// Terraform runs a separate provider process for each plan, apply and refresh,
+// and for each provider configuration, so each process writes its own file.
+func ProcessReportPath(path string, pid int) string {
+	ext := filepath.Ext(path)
+	return strings.TrimSuffix(path, ext) + "." + strconv.Itoa(pid) + ext
+}
+
+// percentile returns the nearest-rank p-th percentile of the sorted slice d.
+func percentile(d []time.Duration, p float64) time.Duration {
+	if len(d) == 0 {
+		return 0
+	}
+
+	rank := int(math.Ceil(p / 100 * float64(len(d))))
+	return d[max(rank-1, 0)]
+}
+
+func millis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
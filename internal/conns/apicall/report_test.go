@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package apicall
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNewReport(t *testing.T) {
+	t.Parallel()
+
+	calls := []Call{
+		{Resource: "aws_iam_role", Service: "IAM", Operation: "GetRole", Duration: 10 * time.Millisecond, Attempts: 1},
+		{Resource: "aws_iam_role", Service: "IAM", Operation: "GetRole", Duration: 30 * time.Millisecond, Attempts: 3, Throttles: 2},
+		{Resource: "aws_iam_role", Service: "IAM", Operation: "GetRole", Duration: 20 * time.Millisecond, Attempts: 1, Err: errors.New("boom")},
+		{Resource: "aws_iam_role", Service: "IAM", Operation: "ListRoleTags", Duration: 5 * time.Millisecond, Attempts: 1},
+		{Service: "STS", Operation: "GetCallerIdentity", Duration: 7 * time.Millisecond},
+	}
+
+	report := NewReport(calls)
+
+	if got, want := report.TotalCalls, len(calls); got != want {
+		t.Errorf("TotalCalls = %d, want %d", got, want)
+	}
+	if got, want := len(report.Entries), 3; got != want {
+		t.Fatalf("len(Entries) = %d, want %d", got, want)
+	}
+
+	e := report.Entries[0]
+	if got, want := e.Operation, "GetRole"; got != want {
+		t.Fatalf("Entries[0].Operation = %q, want %q", got, want)
+	}
+	if got, want := e.Calls, 3; got != want {
+		t.Errorf("Calls = %d, want %d", got, want)
+	}
+	if got, want := e.Errors, 1; got != want {
+		t.Errorf("Errors = %d, want %d", got, want)
+	}
+	if got, want := e.Retries, 2; got != want {
+		t.Errorf("Retries = %d, want %d", got, want)
+	}
+	if got, want := e.Throttles, 2; got != want {
+		t.Errorf("Throttles = %d, want %d", got, want)
+	}
+	if got, want := e.P50Millis, 20.0; got != want {
+		t.Errorf("P50Millis = %v, want %v", got, want)
+	}
+	if got, want := e.P95Millis, 30.0; got != want {
+		t.Errorf("P95Millis = %v, want %v", got, want)
+	}
+
+	// Ties on call count are ordered by resource name; unattributed calls sort first.
+	if got, want := report.Entries[1].Service, "STS"; got != want {
+		t.Errorf("Entries[1].Service = %q, want %q", got, want)
+	}
+	if got, want := report.Entries[2].Operation, "ListRoleTags"; got != want {
+		t.Errorf("Entries[2].Operation = %q, want %q", got, want)
+	}
+}
+
+func TestNewReport_empty(t *testing.T) {
+	t.Parallel()
+
+	report := NewReport(nil)
+
+	if report.TotalCalls != 0 {
+		t.Errorf("TotalCalls = %d, want 0", report.TotalCalls)
+	}
+	if report.Entries == nil || len(report.Entries) != 0 {
+		t.Errorf("Entries = %v, want empty non-nil slice", report.Entries)
+	}
+}
+
+func TestReport_WriteFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "report.json")
+	report := NewReport([]Call{
+		{Resource: "aws_s3_bucket", Service: "S3", Operation: "HeadBucket", Duration: time.Millisecond},
+	})
+
+	if err := report.WriteFile(path); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+
+	var got Report
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if got.TotalCalls != 1 || len(got.Entries) != 1 || got.Entries[0].Resource != "aws_s3_bucket" {
+		t.Errorf("round-tripped report = %+v", got)
+	}
+}
+
+func TestSummary_boundedSamples(t *testing.T) {
+	t.Parallel()
+
+	s := NewSummary()
+	for i := range 3 * maxLatencySamples {
+		s.Add(Call{Resource: "aws_iam_role", Service: "IAM", Operation: "GetRole", Duration: time.Duration(i) * time.Millisecond})
+	}
+
+	for _, e := range s.entries {
+		if got, want := len(e.durations), maxLatencySamples; got != want {
+			t.Errorf("len(durations) = %d, want %d", got, want)
+		}
+	}
+
+	report := s.Report()
+	if got, want := report.TotalCalls, 3*maxLatencySamples; got != want {
+		t.Errorf("TotalCalls = %d, want %d", got, want)
+	}
+	if got, want := report.Entries[0].Calls, 3*maxLatencySamples; got != want {
+		t.Errorf("Calls = %d, want %d", got, want)
+	}
+}
+
+func TestProcessReportPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		path string
+		want string
+	}{
+		{path: "api-calls.json", want: "api-calls.1234.json"},
+		{path: "/tmp/reports/api-calls.json", want: "/tmp/reports/api-calls.1234.json"},
+		{path: "api-calls", want: "api-calls.1234"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.path, func(t *testing.T) {
+			t.Parallel()
+
+			if got := ProcessReportPath(testCase.path, 1234); got != testCase.want {
+				t.Errorf("ProcessReportPath(%q) = %q, want %q", testCase.path, got, testCase.want)
+			}
+		})
+	}
+}
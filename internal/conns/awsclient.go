@@ -22,10 +22,13 @@ import (
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns/apicall"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/ratelimit"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/dns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
@@ -34,8 +37,10 @@ import (
 
 type AWSClient struct {
 	accountID                 string
+	apiCallReportPath         string // From provider configuration.
 	awsConfig                 *aws.Config
 	callRecorder              *apicall.Recorder         // For acceptance tests asserting which AWS API operations are made.
+	callSummary               *apicall.Summary          // For the API call report.
 	clients                   map[string]map[string]any // Region -> service package name -> API client.
 	defaultTagsConfig         *tftags.DefaultConfig
 	endpoints                 map[string]string // From provider configuration.
@@ -43,9 +48,14 @@ type AWSClient struct {
 	ignoreTagsConfig          *tftags.IgnoreConfig
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	namingConfig              *create.NamingConfig // From provider configuration.
 	partition                 endpoints.Partition
-	randomnessSource          rand.Source // For VCR deterministic randomness.
+	policyValidation          string         // From provider configuration.
+	randomnessSource          rand.Source    // For VCR deterministic randomness.
+	resourceConcurrencyLimits map[string]int // Resource type name -> concurrency limit. From provider configuration.
+	resourceSemaphores        map[string]*tfsync.Semaphore
 	servicePackages           map[string]ServicePackage
+	serviceRateLimiters       map[string]*ratelimit.Limiter // Service package name -> client-side rate limiter. From provider configuration.
 	s3ExpressClient           *s3.Client
 	s3OriginalRegion          string // Original region for S3-compatible storage
 	s3UsePathStyle            bool   // From provider configuration.
@@ -95,6 +105,18 @@ func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.TagPolicyConfig {
 	return c.tagPolicyConfig
 }
 
+// PolicyValidation returns the severity with which IAM Access Analyzer policy validation findings are reported.
+// An empty string means that policy documents are not validated.
+func (c *AWSClient) PolicyValidation(context.Context) string {
+	return c.policyValidation
+}
+
+// NamingConfig returns the naming convention applied to resource names.
+// A nil value means that no naming convention is applied.
+func (c *AWSClient) NamingConfig(context.Context) *create.NamingConfig {
+	return c.namingConfig
+}
+
 func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
 	return c.awsConfig.Copy()
 }
@@ -135,6 +157,61 @@ func (c *AWSClient) SetCallRecorder(r *apicall.Recorder) {
 	c.callRecorder = r
 }
 
+// ResourceConcurrencySemaphore returns the semaphore limiting concurrent Create,
+// Update and Delete operations for the specified resource type, or nil if the
+// resource type is not limited.
+//
+// defaultLimit is the resource type's registered default, which is overridden by
+// any value in the provider's resource_concurrency_limits argument. A value of 0 there
+// removes the default limit.
+// The semaphore is shared by all operations on the resource type made by this provider instance.
+func (c *AWSClient) ResourceConcurrencySemaphore(_ context.Context, typeName string, defaultLimit int) *tfsync.Semaphore {
+	limit := defaultLimit
+	if v, ok := c.resourceConcurrencyLimits[typeName]; ok {
+		limit = v
+	}
+	if limit <= 0 {
+		return nil
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if s, ok := c.resourceSemaphores[typeName]; ok {
+		return s
+	}
+
+	if c.resourceSemaphores == nil {
+		c.resourceSemaphores = make(map[string]*tfsync.Semaphore)
+	}
+	s := tfsync.NewSemaphore(limit)
+	c.resourceSemaphores[typeName] = s
+
+	return s
+}
+
+// WriteAPICallReport writes a summary of the AWS API calls made during this
+// provider process's lifetime to a file named after the provider's
+// api_call_report argument and the process ID (see apicall.ProcessReportPath).
+// It is a no-op when no report was requested.
+func (c *AWSClient) WriteAPICallReport(ctx context.Context) error {
+	if c == nil || c.apiCallReportPath == "" || c.callSummary == nil {
+		return nil
+	}
+
+	pid := os.Getpid()
+	path := apicall.ProcessReportPath(c.apiCallReportPath, pid)
+
+	tflog.Debug(ctx, "Writing AWS API call report", map[string]any{
+		"path": path,
+	})
+
+	report := c.callSummary.Report()
+	report.ProcessID = pid
+
+	return report.WriteFile(path)
+}
+
 // RequestContext augments ctx with the per-request observability and
 // configuration values that every framework- and SDKv2-managed AWS API
 // call needs. This is the single point where these are wired; new
@@ -145,7 +222,9 @@ func (c *AWSClient) SetCallRecorder(r *apicall.Recorder) {
 //   - tag configuration (default, ignore, policy)
 //   - the AWS client logger
 //   - the VCR randomness source, when VCR testing is active
-//   - the API-call recorder, when one is attached for the test
+//   - the API-call recorder, when one is attached for a test, and the API call
+//     summary, when a call report was requested, labelled with the calling
+//     resource type
 //   - the AutoFlex logger
 //
 // Each element is a no-op when the corresponding feature is inactive.
@@ -162,10 +241,23 @@ func (c *AWSClient) RequestContext(ctx context.Context) context.Context {
 	}
 	ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 	ctx = c.RegisterLogger(ctx)
+	if c.namingConfig != nil {
+		if inContext, ok := FromContext(ctx); ok {
+			if nc := c.namingConfig.Convention(inContext.TypeName(), c.Region(ctx), c.AccountID(ctx)); nc != nil {
+				ctx = create.NewNamingContext(ctx, nc)
+			}
+		}
+	}
 	if s := c.RandomnessSource(); s != nil {
 		ctx = vcr.NewContext(ctx, s)
 	}
-	ctx = apicall.NewContext(ctx, c.callRecorder)
+	if c.callRecorder != nil || c.callSummary != nil {
+		ctx = apicall.NewContext(ctx, c.callRecorder)
+		ctx = apicall.NewSummaryContext(ctx, c.callSummary)
+		if inContext, ok := FromContext(ctx); ok {
+			ctx = apicall.NewResourceContext(ctx, inContext.TypeName())
+		}
+	}
 	return fwflex.RegisterLogger(ctx)
 }
 
@@ -485,6 +577,12 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	}
 
 	config := c.apiClientConfig(ctx, servicePackageName)
+	// A configured client-side rate limit is shared by every client (all Regions) for the service.
+	if limiter, ok := c.serviceRateLimiters[servicePackageName]; ok {
+		cfg := c.awsConfig.Copy()
+		cfg.APIOptions = append(cfg.APIOptions, limiter.Middleware())
+		config["aws_sdkv2_config"] = &cfg
+	}
 	maps.Copy(config, extra) // Extras overwrite per-service defaults.
 	client, err := v.NewClient(ctx, config)
 	if err != nil {
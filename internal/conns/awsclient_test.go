@@ -598,3 +598,73 @@ func TestAWSClientRegionalARNWithRegion(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAWSClientResourceConcurrencySemaphore(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := t.Context()
+	testCases := []struct {
+		Name                      string
+		ResourceConcurrencyLimits map[string]int
+		DefaultLimit              int
+		Expected                  int // 0 means no semaphore.
+	}{
+		{
+			Name: "no limit",
+		},
+		{
+			Name:         "default limit",
+			DefaultLimit: 5,
+			Expected:     5,
+		},
+		{
+			Name: "configured limit",
+			ResourceConcurrencyLimits: map[string]int{
+				"aws_route53_record": 2,
+			},
+			Expected: 2,
+		},
+		{
+			Name: "configured limit overrides default limit",
+			ResourceConcurrencyLimits: map[string]int{
+				"aws_route53_record": 2,
+			},
+			DefaultLimit: 5,
+			Expected:     2,
+		},
+		{
+			Name: "configured zero removes default limit",
+			ResourceConcurrencyLimits: map[string]int{
+				"aws_route53_record": 0,
+			},
+			DefaultLimit: 5,
+		},
+		{
+			Name: "other resource type configured",
+			ResourceConcurrencyLimits: map[string]int{
+				"aws_lambda_permission": 0,
+			},
+			DefaultLimit: 5,
+			Expected:     5,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &AWSClient{
+				resourceConcurrencyLimits: testCase.ResourceConcurrencyLimits,
+			}
+
+			var got int
+			if s := client.ResourceConcurrencySemaphore(ctx, "aws_route53_record", testCase.DefaultLimit); s != nil {
+				got = s.Limit()
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %d, expected %d", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -19,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns/apicall"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/ratelimit"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -31,6 +33,7 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	APICallReport                  string
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
@@ -45,14 +48,18 @@ type Config struct {
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
+	NamingConfig                   *create.NamingConfig // Naming convention applied to resource names.
 	NoProxy                        string
+	PolicyValidation               string // Severity with which IAM Access Analyzer policy validation findings are reported.
 	Profile                        string
 	Region                         string
+	ResourceConcurrencyLimits      map[string]int // Resource type name -> maximum concurrent Create, Update and Delete operations.
 	RetryMode                      aws.RetryMode
 	S3OriginalRegion               string
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceRateLimits              map[string]ratelimit.Config // Service package name -> client-side rate limit.
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -61,6 +68,7 @@ type Config struct {
 	STSRegion                      string
 	SuppressDebugLog               bool
 	TagPolicyConfig                *tftags.TagPolicyConfig
+	TagPolicyFile                  string // Local tag policy document used instead of the effective tag policy.
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -213,7 +221,20 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	// Fetch tag policy details when enforced
-	if c.TagPolicyConfig != nil {
+	if c.TagPolicyConfig != nil && c.TagPolicyFile != "" {
+		tflog.Debug(ctx, "Reading tag policy", map[string]any{
+			"filename": c.TagPolicyFile,
+		})
+		reqTags, tagRules, err := tagpolicy.ReadFile(ctx, c.TagPolicyFile)
+		if err != nil {
+			diags = append(diags, errs.NewErrorDiagnostic(
+				"Reading Tag Policy",
+				fmt.Sprintf("Failed to read the tag policy document %q.\n\nOriginal error: %s", c.TagPolicyFile, err)))
+			return nil, diags
+		}
+		c.TagPolicyConfig.RequiredTags = reqTags
+		c.TagPolicyConfig.TagRules = tagRules
+	} else if c.TagPolicyConfig != nil {
 		tflog.Debug(ctx, "Retrieving tag policy details")
 		reqTags, err := tagpolicy.GetRequiredTags(ctx, cfg)
 		if err != nil {
@@ -225,18 +246,39 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			return nil, diags
 		}
 		c.TagPolicyConfig.RequiredTags = reqTags
+
+		tagRules, err := tagpolicy.GetTagRules(ctx, cfg)
+		if err != nil {
+			// Tag key capitalization and allowed tag value rules were added after required tags,
+			// so a missing permission must not prevent required tags from being enforced.
+			diags = append(diags, errs.NewWarningDiagnostic(
+				"Retrieving Effective Tag Policy",
+				`Failed to retrieve the effective tag policy. Tag key capitalization and allowed tag values will not be enforced. `+
+					`Ensure the calling principal has the "organizations:DescribeEffectivePolicy" IAM permission.`+
+					fmt.Sprintf("\n\nOriginal error: %s", err)))
+		}
+		c.TagPolicyConfig.TagRules = tagRules
 	}
 
 	client.accountID = accountID
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
+	client.namingConfig = c.NamingConfig
+	client.policyValidation = c.PolicyValidation
+	client.resourceConcurrencyLimits = c.ResourceConcurrencyLimits
 	client.tagPolicyConfig = c.TagPolicyConfig
 	client.terraformVersion = c.TerraformVersion
 
+	// Count every AWS API call when a call report has been requested.
+	if c.APICallReport != "" {
+		client.callSummary = apicall.NewSummary()
+		client.apiCallReportPath = c.APICallReport
+	}
+
 	// Register the apicall recording middleware on the base aws.Config.
 	// Per-service NewFromConfig() inherits cfg.APIOptions, so this single
 	// registration covers every service. The middleware is a no-op unless a
-	// *apicall.Recorder is attached to the request context.
+	// *apicall.Recorder or *apicall.Summary is attached to the request context.
 	cfg.APIOptions = append(cfg.APIOptions, apicall.Middleware())
 
 	// Used for lazy-loading AWS API clients.
@@ -245,6 +287,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.endpoints = c.Endpoints
 	client.logger = logger
 	client.s3OriginalRegion = c.S3OriginalRegion
+	client.serviceRateLimiters = make(map[string]*ratelimit.Limiter, len(c.ServiceRateLimits))
+	for servicePackageName, v := range c.ServiceRateLimits {
+		client.serviceRateLimiters[servicePackageName] = ratelimit.New(v)
+	}
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
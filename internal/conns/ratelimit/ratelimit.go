@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package ratelimit implements the client-side, per-service rate limits
+// configured with the provider's service_rate_limits blocks.
+//
+// A Limiter combines a token bucket (requests per second plus burst) with an
+// optional cap on in-flight requests. It is installed on a service's AWS SDK
+// for Go v2 API client as Smithy middleware that runs once per HTTP attempt,
+// immediately after the retry middleware, so that retries are also paced.
+//
+// This is independent of, and in addition to, the AWS SDK's own retry token
+// bucket configured via token_bucket_rate_limiter_capacity.
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// Config is the client-side rate limit for one service.
+type Config struct {
+	// RequestsPerSecond is the sustained request rate. Zero means unlimited.
+	RequestsPerSecond float64
+	// Burst is the maximum number of requests that may be made at once
+	// before RequestsPerSecond applies. Defaults to 1 when RequestsPerSecond is set.
+	Burst int
+	// MaxConcurrency is the maximum number of in-flight requests. Zero means unlimited.
+	MaxConcurrency int
+}
+
+// Validate returns an error if the configuration is not usable.
+func (c Config) Validate() error {
+	var errs []error
+
+	if c.RequestsPerSecond < 0 {
+		errs = append(errs, fmt.Errorf("requests_per_second must not be negative, got %v", c.RequestsPerSecond))
+	}
+	if c.Burst < 0 {
+		errs = append(errs, fmt.Errorf("burst must not be negative, got %d", c.Burst))
+	}
+	if c.MaxConcurrency < 0 {
+		errs = append(errs, fmt.Errorf("max_concurrency must not be negative, got %d", c.MaxConcurrency))
+	}
+	if c.RequestsPerSecond == 0 && c.MaxConcurrency == 0 {
+		errs = append(errs, errors.New("at least one of requests_per_second or max_concurrency must be set"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Limiter paces requests according to a Config. Construct via New.
+// Safe for concurrent use.
+type Limiter struct {
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+
+	inFlight chan struct{} // nil when concurrency is unlimited.
+
+	now func() time.Time
+}
+
+// New returns a Limiter for cfg.
+func New(cfg Config) *Limiter {
+	l := &Limiter{
+		rate: cfg.RequestsPerSecond,
+		now:  time.Now,
+	}
+
+	if l.rate > 0 {
+		l.burst = float64(max(cfg.Burst, 1))
+		l.tokens = l.burst
+	}
+	if cfg.MaxConcurrency > 0 {
+		l.inFlight = make(chan struct{}, cfg.MaxConcurrency)
+	}
+
+	return l
+}
+
+// Wait blocks until a request may be made or ctx is done.
+// On success the returned function must be called once the request completes.
+func (l *Limiter) Wait(ctx context.Context) (func(), error) {
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	release := func() {
+		if l.inFlight != nil {
+			<-l.inFlight
+		}
+	}
+
+	if d := l.reserve(); d > 0 {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			release()
+			return nil, ctx.Err()
+		}
+	}
+
+	return release, nil
+}
+
+// reserve takes one token from the bucket and returns how long the caller
+// must wait before the token is valid.
+func (l *Limiter) reserve() time.Duration {
+	if l.rate == 0 {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// MiddlewareID is the Smithy stack identifier of the rate limiting middleware.
+const MiddlewareID = "TerraformProviderAWSServiceRateLimit"
+
+type limiterMiddleware struct {
+	limiter *Limiter
+}
+
+func (limiterMiddleware) ID() string { return MiddlewareID }
+
+func (m limiterMiddleware) HandleFinalize(
+	ctx context.Context,
+	in middleware.FinalizeInput,
+	next middleware.FinalizeHandler,
+) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	release, err := m.limiter.Wait(ctx)
+	if err != nil {
+		return middleware.FinalizeOutput{}, middleware.Metadata{}, fmt.Errorf("waiting for client-side rate limit: %w", err)
+	}
+	defer release()
+
+	return next.HandleFinalize(ctx, in)
+}
+
+// Middleware returns a stack mutator that registers l on a Smithy stack.
+// Idempotent. Append to the APIOptions of the service's aws.Config.
+func (l *Limiter) Middleware() func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		if _, ok := stack.Finalize.Get(MiddlewareID); ok {
+			return nil
+		}
+
+		m := limiterMiddleware{limiter: l}
+		if _, ok := stack.Finalize.Get((&retry.Attempt{}).ID()); ok {
+			return stack.Finalize.Insert(m, (&retry.Attempt{}).ID(), middleware.After)
+		}
+		return stack.Finalize.Add(m, middleware.After)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config  Config
+		wantErr bool
+	}{
+		"rate only": {
+			config: Config{RequestsPerSecond: 5},
+		},
+		"concurrency only": {
+			config: Config{MaxConcurrency: 2},
+		},
+		"empty": {
+			wantErr: true,
+		},
+		"negative rate": {
+			config:  Config{RequestsPerSecond: -1, MaxConcurrency: 1},
+			wantErr: true,
+		},
+		"negative burst": {
+			config:  Config{RequestsPerSecond: 1, Burst: -1},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.config.Validate()
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("Validate() err = %v, wantErr %t", err, want)
+			}
+		})
+	}
+}
+
+func TestLimiter_reserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := New(Config{RequestsPerSecond: 2, Burst: 2})
+	l.now = func() time.Time { return now }
+
+	// The burst is available immediately.
+	for i := range 2 {
+		if d := l.reserve(); d != 0 {
+			t.Fatalf("reserve() #%d = %s, want 0", i, d)
+		}
+	}
+
+	// The next token is half a second away at 2 requests per second.
+	if got, want := l.reserve(), 500*time.Millisecond; got != want {
+		t.Errorf("reserve() = %s, want %s", got, want)
+	}
+
+	// A second later, one token has been repaid on top of the one owed.
+	now = now.Add(time.Second)
+	if got, want := l.reserve(), time.Duration(0); got != want {
+		t.Errorf("reserve() after 1s = %s, want %s", got, want)
+	}
+}
+
+func TestLimiter_WaitConcurrency(t *testing.T) {
+	t.Parallel()
+
+	l := New(Config{MaxConcurrency: 1})
+
+	release, err := l.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("second Wait err = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	release()
+
+	release, err = l.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("Wait after release: %v", err)
+	}
+	release()
+}
+
+func TestLimiter_MiddlewareIdempotent(t *testing.T) {
+	t.Parallel()
+
+	l := New(Config{MaxConcurrency: 1})
+	stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+
+	for range 2 {
+		if err := l.Middleware()(stack); err != nil {
+			t.Fatalf("adding middleware: %v", err)
+		}
+	}
+
+	if got, want := len(stack.Finalize.List()), 1; got != want {
+		t.Errorf("len(Finalize) = %d, want %d", got, want)
+	}
+}
@@ -105,12 +105,16 @@ func NewNameGenerator(optFns ...NameGeneratorOptionsFunc) *nameGenerator {
 }
 
 // Generate generates a new name.
+// If Context contains a naming convention with a prefix, that prefix is used instead of the default prefix.
 func (g *nameGenerator) Generate(ctx context.Context) string {
 	if g.configuredName != "" {
 		return g.configuredName
 	}
 
 	prefix := g.defaultPrefix
+	if nc, ok := NamingConventionFromContext(ctx); ok && nc.Prefix != "" {
+		prefix = nc.Prefix
+	}
 	if g.configuredPrefix != "" {
 		prefix = g.configuredPrefix
 	}
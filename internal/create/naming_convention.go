@@ -0,0 +1,231 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+const (
+	namingTokenAccountID = "{account_id}"
+	namingTokenEnv       = "{env}"
+	namingTokenRegion    = "{region}"
+)
+
+var namingTokenRegexp = regexache.MustCompile(`\{[^{}]*\}`)
+
+// generatedNameSuffixSample is a unique suffix of generated names that contains every character such suffixes may contain.
+var generatedNameSuffixSample = strings.Repeat(hexLower, 2)[:UniqueIDSuffixLength]
+
+// NamingConfig is the naming convention configured with the provider's naming block.
+type NamingConfig struct {
+	// Env is the value of the {env} prefix template token.
+	Env string
+
+	// Default is the naming policy for resource types without their own policy.
+	Default NamingPolicy
+
+	// ResourceTypes is a mapping of resource type names to naming policies.
+	// Non-zero fields override those of the default policy.
+	ResourceTypes map[string]NamingPolicy
+}
+
+// NamingPolicy is the naming policy for one or more resource types.
+type NamingPolicy struct {
+	// Prefix is the prefix template of resource names.
+	// It may contain the {region}, {account_id} and {env} tokens.
+	Prefix string
+
+	// MaxLength is the maximum length of resource names. Zero means unlimited.
+	MaxLength int
+
+	// CharacterSet is a regular expression matching a single allowed character of resource names,
+	// e.g. "[a-z0-9-]". Empty means any character.
+	CharacterSet string
+}
+
+// Validate returns an error if the naming policy is not usable.
+func (p NamingPolicy) Validate() error {
+	var errs []error
+
+	for _, token := range namingTokenRegexp.FindAllString(p.Prefix, -1) {
+		switch token {
+		case namingTokenAccountID, namingTokenEnv, namingTokenRegion:
+		default:
+			errs = append(errs, fmt.Errorf("prefix %q contains unsupported token %s, supported tokens are %s, %s and %s", p.Prefix, token, namingTokenRegion, namingTokenAccountID, namingTokenEnv))
+		}
+	}
+	if p.MaxLength < 0 {
+		errs = append(errs, fmt.Errorf("max_length must not be negative, got %d", p.MaxLength))
+	}
+	if p.CharacterSet != "" {
+		if _, err := characterSetRegexp(p.CharacterSet); err != nil {
+			errs = append(errs, fmt.Errorf("character_set %q is not a valid regular expression: %w", p.CharacterSet, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate returns an error if the naming configuration is not usable.
+func (c *NamingConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	policies := []NamingPolicy{c.Default}
+	for _, v := range c.ResourceTypes {
+		policies = append(policies, v)
+	}
+
+	var errs []error
+
+	for _, v := range policies {
+		if err := v.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+		if c.Env == "" && strings.Contains(v.Prefix, namingTokenEnv) {
+			errs = append(errs, fmt.Errorf("env must be set when prefix %q contains the %s token", v.Prefix, namingTokenEnv))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Convention returns the naming convention for the specified resource type in the specified Region and account.
+// It returns nil if no naming policy applies.
+func (c *NamingConfig) Convention(typeName, region, accountID string) *NamingConvention {
+	if c == nil {
+		return nil
+	}
+
+	policy := c.Default
+	if v, ok := c.ResourceTypes[typeName]; ok {
+		if v.Prefix != "" {
+			policy.Prefix = v.Prefix
+		}
+		if v.MaxLength != 0 {
+			policy.MaxLength = v.MaxLength
+		}
+		if v.CharacterSet != "" {
+			policy.CharacterSet = v.CharacterSet
+		}
+	}
+
+	if policy == (NamingPolicy{}) {
+		return nil
+	}
+
+	return &NamingConvention{
+		Prefix:       strings.NewReplacer(namingTokenAccountID, accountID, namingTokenEnv, c.Env, namingTokenRegion, region).Replace(policy.Prefix),
+		MaxLength:    policy.MaxLength,
+		CharacterSet: policy.CharacterSet,
+	}
+}
+
+// NamingConvention is the naming convention of a resource.
+type NamingConvention struct {
+	// Prefix is the required prefix of names, with all tokens replaced.
+	// It is used instead of the default prefix of generated names.
+	Prefix string
+
+	// MaxLength is the maximum length of names. Zero means unlimited.
+	MaxLength int
+
+	// CharacterSet is a regular expression matching a single allowed character of names.
+	CharacterSet string
+}
+
+// ValidateName returns an error if the specified configured name does not follow the naming convention.
+func (nc *NamingConvention) ValidateName(name string) error {
+	if nc == nil {
+		return nil
+	}
+
+	var errs []error
+
+	if !strings.HasPrefix(name, nc.Prefix) {
+		errs = append(errs, fmt.Errorf("name %q must begin with %q", name, nc.Prefix))
+	}
+	if nc.MaxLength > 0 && len(name) > nc.MaxLength {
+		errs = append(errs, fmt.Errorf("name %q must be at most %d characters long", name, nc.MaxLength))
+	}
+	if err := nc.validateCharacterSet("name", name); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateNamePrefix returns an error if names generated with the specified configured name prefix,
+// including their unique suffix, would not follow the naming convention.
+// An empty name prefix validates names generated with the naming convention's prefix.
+func (nc *NamingConvention) ValidateNamePrefix(namePrefix string) error {
+	if nc == nil {
+		return nil
+	}
+
+	var errs []error
+
+	if namePrefix == "" {
+		namePrefix = nc.Prefix
+	} else if !strings.HasPrefix(namePrefix, nc.Prefix) {
+		errs = append(errs, fmt.Errorf("name prefix %q must begin with %q", namePrefix, nc.Prefix))
+	}
+
+	generatedName := namePrefix + generatedNameSuffixSample
+	if nc.MaxLength > 0 && len(generatedName) > nc.MaxLength {
+		errs = append(errs, fmt.Errorf("name prefix %q must be at most %d characters long to allow for the %d character unique suffix of generated names", namePrefix, nc.MaxLength-UniqueIDSuffixLength, UniqueIDSuffixLength))
+	}
+	if err := nc.validateCharacterSet("name prefix", namePrefix); err != nil {
+		errs = append(errs, err)
+	} else if err := nc.validateCharacterSet("generated name", generatedName); err != nil {
+		errs = append(errs, fmt.Errorf("%w, but the unique suffix of generated names contains the characters %q", err, hexLower))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (nc *NamingConvention) validateCharacterSet(what, s string) error {
+	if nc.CharacterSet == "" {
+		return nil
+	}
+
+	re, err := characterSetRegexp(nc.CharacterSet)
+	if err != nil {
+		return err
+	}
+
+	if !re.MatchString(s) {
+		return fmt.Errorf("%s %q must only contain characters matching %s", what, s, nc.CharacterSet)
+	}
+
+	return nil
+}
+
+// characterSetRegexp returns a regular expression matching strings made up of characters matching characterSet.
+func characterSetRegexp(characterSet string) (*regexp.Regexp, error) {
+	return regexp.Compile(`^(?:` + characterSet + `)*$`)
+}
+
+// NewNamingContext returns a Context enhanced with the specified naming convention.
+func NewNamingContext(ctx context.Context, nc *NamingConvention) context.Context {
+	return context.WithValue(ctx, namingKey, nc)
+}
+
+// NamingConventionFromContext returns the naming convention kept in Context, if any.
+func NamingConventionFromContext(ctx context.Context) (*NamingConvention, bool) {
+	v, ok := ctx.Value(namingKey).(*NamingConvention)
+	return v, ok && v != nil
+}
+
+type namingKeyType int
+
+var namingKey namingKeyType
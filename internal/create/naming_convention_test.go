@@ -0,0 +1,346 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package create
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNamingConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName      string
+		config        *NamingConfig
+		expectedError *regexp.Regexp
+	}{
+		{
+			testName: "nil",
+		},
+		{
+			testName: "valid",
+			config: &NamingConfig{
+				Env: "prod",
+				Default: NamingPolicy{
+					Prefix:       "{env}-{region}-{account_id}-",
+					MaxLength:    64,
+					CharacterSet: "[a-z0-9-]",
+				},
+				ResourceTypes: map[string]NamingPolicy{
+					"aws_elb": {MaxLength: 32},
+				},
+			},
+		},
+		{
+			testName: "unsupported token",
+			config: &NamingConfig{
+				Default: NamingPolicy{Prefix: "{team}-"},
+			},
+			expectedError: regexache.MustCompile(`unsupported token \{team\}`),
+		},
+		{
+			testName: "env not set",
+			config: &NamingConfig{
+				ResourceTypes: map[string]NamingPolicy{
+					"aws_sqs_queue": {Prefix: "{env}-"},
+				},
+			},
+			expectedError: regexache.MustCompile(`env must be set`),
+		},
+		{
+			testName: "negative max length",
+			config: &NamingConfig{
+				Default: NamingPolicy{MaxLength: -1},
+			},
+			expectedError: regexache.MustCompile(`max_length must not be negative`),
+		},
+		{
+			testName: "invalid character set",
+			config: &NamingConfig{
+				Default: NamingPolicy{CharacterSet: "[a-z"},
+			},
+			expectedError: regexache.MustCompile(`character_set "\[a-z" is not a valid regular expression`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.config.Validate()
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error matching %s, got none", testCase.expectedError)
+			}
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Errorf("error %q does not match %s", err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestNamingConfigConvention(t *testing.T) {
+	t.Parallel()
+
+	config := &NamingConfig{
+		Env: "prod",
+		Default: NamingPolicy{
+			Prefix:       "{env}-{region}-",
+			MaxLength:    64,
+			CharacterSet: "[a-z0-9-]",
+		},
+		ResourceTypes: map[string]NamingPolicy{
+			"aws_elb": {
+				MaxLength: 32,
+			},
+			"aws_sqs_queue": {
+				Prefix:       "{account_id}_",
+				CharacterSet: "[a-zA-Z0-9_-]",
+			},
+		},
+	}
+
+	testCases := []struct {
+		testName string
+		config   *NamingConfig
+		typeName string
+		expected *NamingConvention
+	}{
+		{
+			testName: "nil",
+			typeName: "aws_sns_topic",
+		},
+		{
+			testName: "empty",
+			config:   &NamingConfig{Env: "prod"},
+			typeName: "aws_sns_topic",
+		},
+		{
+			testName: "default",
+			config:   config,
+			typeName: "aws_sns_topic",
+			expected: &NamingConvention{
+				Prefix:       "prod-us-west-2-", //lintignore:AWSAT003
+				MaxLength:    64,
+				CharacterSet: "[a-z0-9-]",
+			},
+		},
+		{
+			testName: "resource type max length",
+			config:   config,
+			typeName: "aws_elb",
+			expected: &NamingConvention{
+				Prefix:       "prod-us-west-2-", //lintignore:AWSAT003
+				MaxLength:    32,
+				CharacterSet: "[a-z0-9-]",
+			},
+		},
+		{
+			testName: "resource type prefix and character set",
+			config:   config,
+			typeName: "aws_sqs_queue",
+			expected: &NamingConvention{
+				Prefix:       "123456789012_",
+				MaxLength:    64,
+				CharacterSet: "[a-zA-Z0-9_-]",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.config.Convention(testCase.typeName, "us-west-2", "123456789012") //lintignore:AWSAT003
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNamingConventionValidateName(t *testing.T) {
+	t.Parallel()
+
+	nc := &NamingConvention{
+		Prefix:       "prod-",
+		MaxLength:    16,
+		CharacterSet: "[a-z0-9-]",
+	}
+
+	testCases := []struct {
+		testName      string
+		name          string
+		expectedError *regexp.Regexp
+	}{
+		{
+			testName: "valid",
+			name:     "prod-orders",
+		},
+		{
+			testName:      "missing prefix",
+			name:          "dev-orders",
+			expectedError: regexache.MustCompile(`name "dev-orders" must begin with "prod-"`),
+		},
+		{
+			testName:      "too long",
+			name:          "prod-orders-archive",
+			expectedError: regexache.MustCompile(`must be at most 16 characters long`),
+		},
+		{
+			testName:      "invalid characters",
+			name:          "prod-Orders",
+			expectedError: regexache.MustCompile(`name "prod-Orders" must only contain characters matching \[a-z0-9-\]`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			err := nc.ValidateName(testCase.name)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error matching %s, got none", testCase.expectedError)
+			}
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Errorf("error %q does not match %s", err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestNamingConventionValidateNamePrefix(t *testing.T) {
+	t.Parallel()
+
+	nc := &NamingConvention{
+		Prefix:       "prod-",
+		MaxLength:    UniqueIDSuffixLength + 10,
+		CharacterSet: "[a-z0-9-]",
+	}
+
+	testCases := []struct {
+		testName      string
+		nc            *NamingConvention
+		namePrefix    string
+		expectedError *regexp.Regexp
+	}{
+		{
+			testName: "generated",
+		},
+		{
+			testName:   "valid",
+			namePrefix: "prod-app-",
+		},
+		{
+			testName:      "missing prefix",
+			namePrefix:    "app-",
+			expectedError: regexache.MustCompile(`name prefix "app-" must begin with "prod-"`),
+		},
+		{
+			testName:      "too long",
+			namePrefix:    "prod-orders-",
+			expectedError: regexache.MustCompile(`name prefix "prod-orders-" must be at most 10 characters long`),
+		},
+		{
+			testName:      "invalid characters",
+			namePrefix:    "prod_",
+			expectedError: regexache.MustCompile(`name prefix "prod_" must only contain characters matching`),
+		},
+		{
+			testName: "generated suffix invalid characters",
+			nc: &NamingConvention{
+				Prefix:       "prod-",
+				CharacterSet: "[a-z-]",
+			},
+			expectedError: regexache.MustCompile(`generated name "prod-[[:xdigit:]]{26}" must only contain characters matching \[a-z-\], but the unique suffix`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			nc := nc
+			if testCase.nc != nil {
+				nc = testCase.nc
+			}
+
+			err := nc.ValidateNamePrefix(testCase.namePrefix)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error matching %s, got none", testCase.expectedError)
+			}
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Errorf("error %q does not match %s", err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestNameWithNamingConvention(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName         string
+		configuredName   string
+		configuredPrefix string
+		expectedRegexp   *regexp.Regexp
+	}{
+		{
+			testName:       "no configured name or prefix",
+			expectedRegexp: regexache.MustCompile(fmt.Sprintf("^prod-[[:xdigit:]]{%d}$", UniqueIDSuffixLength)),
+		},
+		{
+			testName:       "configured name only",
+			configuredName: "testing",
+			expectedRegexp: regexache.MustCompile(`^testing$`),
+		},
+		{
+			testName:         "configured prefix only",
+			configuredPrefix: "prod-pfx-",
+			expectedRegexp:   regexache.MustCompile(fmt.Sprintf("^prod-pfx-[[:xdigit:]]{%d}$", UniqueIDSuffixLength)),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+			ctx := NewNamingContext(t.Context(), &NamingConvention{Prefix: "prod-"})
+
+			got := Name(ctx, testCase.configuredName, testCase.configuredPrefix)
+
+			if !testCase.expectedRegexp.MatchString(got) {
+				t.Errorf("Name(%q, %q) = %v, does not match %s", testCase.configuredName, testCase.configuredPrefix, got, testCase.expectedRegexp)
+			}
+		})
+	}
+}
@@ -24,6 +24,12 @@ const (
 	// Default AWS region for tests (AWS Go SDK does not provide this as constant)
 	DefaultRegion = "AWS_DEFAULT_REGION"
 
+	// Disables use of the EC2 Instance Metadata Service (AWS Go SDK does not provide this as constant)
+	EC2MetadataDisabled = "AWS_EC2_METADATA_DISABLED"
+
+	// Endpoint URL for all services (AWS Go SDK does not provide this as constant)
+	EndpointURL = "AWS_ENDPOINT_URL"
+
 	// Default AWS shared configuration profile for tests (AWS Go SDK does not provide this as constant)
 	Profile = "AWS_PROFILE"
 
@@ -80,6 +86,17 @@ const (
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
 )
 
+// Custom environment variables used for running acceptance tests against a local AWS emulator
+const (
+	// The base URL of a local AWS API emulator, e.g. http://localhost:4566.
+	// When set, acceptance tests run in emulator mode with every service endpoint set to this URL
+	Emulator = "TF_ACC_EMULATOR_ENDPOINT"
+
+	// Comma-separated list of the service packages, e.g. "s3,sqs,logs", supported by the emulator.
+	// In emulator mode, tests of other services are skipped. If unset, all services are tested
+	EmulatorServices = "TF_ACC_EMULATOR_SERVICES"
+)
+
 // Custom environment variables used for assuming a role with resource sweepers
 const (
 	// The ARN of the IAM Role to assume
@@ -13,6 +13,10 @@ import (
 
 // Semaphore can be used to limit concurrent executions.
 // This can be used to work with resources with low quotas.
+//
+// Acceptance tests only. Provider code limiting concurrent operations on
+// low-quota resource types should use the @ConcurrencyLimit annotation, which
+// is backed by internal/sync.Semaphore.
 type Semaphore chan struct{}
 
 var semaphoreKV = &struct {
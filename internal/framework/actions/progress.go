@@ -6,6 +6,7 @@ package actions
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/action"
 )
@@ -19,3 +20,34 @@ func NewSendProgressFunc(response *action.InvokeResponse) SendProgressFunc {
 		})
 	}
 }
+
+// SendProgressEvery sends the formatted message, suffixed with the elapsed time, every interval until the returned stop function is called.
+// It is intended for wrapping existing waiters that have no progress hook of their own.
+// No progress is sent after stop returns.
+func SendProgressEvery(ctx context.Context, cb SendProgressFunc, interval time.Duration, format string, a ...any) (stop func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	message := fmt.Sprintf(format, a...)
+	start := time.Now()
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				cb(ctx, "%s (%s elapsed)", message, time.Since(start).Truncate(time.Second))
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
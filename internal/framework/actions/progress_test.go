@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package actions_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+)
+
+func TestSendProgressEvery(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var (
+		mu       sync.Mutex
+		messages []string
+	)
+	cb := func(_ context.Context, format string, a ...any) {
+		mu.Lock()
+		defer mu.Unlock()
+		messages = append(messages, fmt.Sprintf(format, a...))
+	}
+
+	stop := fwactions.SendProgressEvery(ctx, cb, 10*time.Millisecond, "Waiting for %s", "test")
+	time.Sleep(55 * time.Millisecond)
+	stop()
+
+	mu.Lock()
+	n := len(messages)
+	mu.Unlock()
+
+	if n == 0 {
+		t.Fatal("expected progress messages, got none")
+	}
+	for _, m := range messages {
+		if !strings.HasPrefix(m, "Waiting for test (") || !strings.HasSuffix(m, " elapsed)") {
+			t.Errorf("unexpected progress message: %q", m)
+		}
+	}
+
+	time.Sleep(30 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if got := len(messages); got != n {
+		t.Errorf("expected no progress messages after stop, got %d more", got-n)
+	}
+}
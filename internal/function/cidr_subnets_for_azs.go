@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math/big"
+	"net/netip"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	// minIPv4SubnetPrefixLength and maxIPv4SubnetPrefixLength bound the prefix length of a subnet's IPv4 CIDR block.
+	minIPv4SubnetPrefixLength = 16
+	maxIPv4SubnetPrefixLength = 28
+	// ipv6SubnetPrefixLength is the prefix length of a subnet's IPv6 CIDR block.
+	ipv6SubnetPrefixLength = 64
+)
+
+var _ function.Function = cidrSubnetsForAZsFunction{}
+
+func NewCIDRSubnetsForAZsFunction() function.Function {
+	return &cidrSubnetsForAZsFunction{}
+}
+
+type cidrSubnetsForAZsFunction struct{}
+
+func (f cidrSubnetsForAZsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnets_for_azs"
+}
+
+func (f cidrSubnetsForAZsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_subnets_for_azs Function",
+		MarkdownDescription: "Divides a VPC CIDR block into one subnet CIDR block per tier and Availability Zone. " +
+			"Returns a map of tier name to a map of Availability Zone ID to CIDR block.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block of the VPC",
+			},
+			function.ListParameter{
+				Name:                "availability_zone_ids",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Availability Zone IDs, e.g. `use1-az1`, in which to create subnets",
+			},
+			function.MapParameter{
+				Name:                "prefix_lengths",
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "Map of tier name to the prefix length of each of the tier's subnets. IPv6 subnets must be /64",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.MapType{ElemType: types.StringType},
+		},
+	}
+}
+
+func (f cidrSubnetsForAZsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+	var azIDs []string
+	var prefixLengths map[string]int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &azIDs, &prefixLengths))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := cidrSubnetsForAZs(cidrBlock, azIDs, prefixLengths)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// cidrSubnetsForAZs allocates one subnet per tier and Availability Zone from cidrBlock.
+//
+// Allocation is deterministic: tiers are allocated in order of increasing prefix length
+// (largest subnets first), then by name, and within a tier in Availability Zone order.
+// Each subnet starts at the next address aligned to its size, so no space is wasted
+// between subnets.
+func cidrSubnetsForAZs(cidrBlock string, azIDs []string, prefixLengths map[string]int64) (map[string]map[string]string, error) {
+	if err := itypes.ValidateCIDRBlock(cidrBlock); err != nil {
+		return nil, err
+	}
+
+	prefix := netip.MustParsePrefix(cidrBlock)
+	bits := prefix.Addr().BitLen()
+
+	if len(azIDs) == 0 {
+		return nil, errors.New("at least one Availability Zone ID is required")
+	}
+	for i, azID := range azIDs {
+		if azID == "" {
+			return nil, fmt.Errorf("empty Availability Zone ID at index %d", i)
+		}
+		if slices.Contains(azIDs[:i], azID) {
+			return nil, fmt.Errorf("duplicate Availability Zone ID (%s)", azID)
+		}
+	}
+
+	for tier, prefixLength := range prefixLengths {
+		if prefixLength < int64(prefix.Bits()) || prefixLength > int64(bits) {
+			return nil, fmt.Errorf("tier (%s) prefix length (%d) must be between %d and %d", tier, prefixLength, prefix.Bits(), bits)
+		}
+		if prefix.Addr().Is4() && (prefixLength < minIPv4SubnetPrefixLength || prefixLength > maxIPv4SubnetPrefixLength) {
+			return nil, fmt.Errorf("tier (%s) prefix length (%d) must be between %d and %d for an IPv4 CIDR block", tier, prefixLength, minIPv4SubnetPrefixLength, maxIPv4SubnetPrefixLength)
+		}
+		if prefix.Addr().Is6() && prefixLength != ipv6SubnetPrefixLength {
+			return nil, fmt.Errorf("tier (%s) prefix length (%d) must be %d for an IPv6 CIDR block", tier, prefixLength, ipv6SubnetPrefixLength)
+		}
+	}
+
+	tiers := slices.SortedFunc(maps.Keys(prefixLengths), func(a, b string) int {
+		return cmp.Or(cmp.Compare(prefixLengths[a], prefixLengths[b]), cmp.Compare(a, b))
+	})
+
+	next := new(big.Int).SetBytes(prefix.Addr().AsSlice())
+	end := new(big.Int).Add(next, new(big.Int).Lsh(big.NewInt(1), uint(bits-prefix.Bits())))
+
+	result := make(map[string]map[string]string, len(tiers))
+	for _, tier := range tiers {
+		prefixLength := int(prefixLengths[tier])
+		size := new(big.Int).Lsh(big.NewInt(1), uint(bits-prefixLength))
+
+		result[tier] = make(map[string]string, len(azIDs))
+		for _, azID := range azIDs {
+			// Align to the subnet size.
+			if m := new(big.Int).Mod(next, size); m.Sign() != 0 {
+				next.Add(next, new(big.Int).Sub(size, m))
+			}
+
+			if new(big.Int).Add(next, size).Cmp(end) > 0 {
+				return nil, fmt.Errorf("not enough addresses in %s for tier (%s) subnet in %s", cidrBlock, tier, azID)
+			}
+
+			b := next.FillBytes(make([]byte, bits/8))
+			addr, _ := netip.AddrFromSlice(b)
+			result[tier][azID] = netip.PrefixFrom(addr, prefixLength).String()
+
+			next.Add(next, size)
+		}
+	}
+
+	return result, nil
+}
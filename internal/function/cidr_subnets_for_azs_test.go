@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSubnetsForAZsFunction_ipv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsForAZsFunctionConfig("10.0.0.0/16", `{ private = 19, public = 24, database = 26 }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("private_az1", "10.0.0.0/19"),
+					resource.TestCheckOutput("private_az2", "10.0.32.0/19"),
+					resource.TestCheckOutput("public_az1", "10.0.64.0/24"),
+					resource.TestCheckOutput("public_az2", "10.0.65.0/24"),
+					resource.TestCheckOutput("database_az1", "10.0.66.0/26"),
+					resource.TestCheckOutput("database_az2", "10.0.66.64/26"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsForAZsFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsForAZsFunctionConfig("2600:1f14:abcd:ef00::/56", `{ private = 64, public = 64, database = 64 }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("database_az1", "2600:1f14:abcd:ef00::/64"),
+					resource.TestCheckOutput("database_az2", "2600:1f14:abcd:ef01::/64"),
+					resource.TestCheckOutput("private_az1", "2600:1f14:abcd:ef02::/64"),
+					resource.TestCheckOutput("private_az2", "2600:1f14:abcd:ef03::/64"),
+					resource.TestCheckOutput("public_az1", "2600:1f14:abcd:ef04::/64"),
+					resource.TestCheckOutput("public_az2", "2600:1f14:abcd:ef05::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsForAZsFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsForAZsFunctionConfig("10.0.0.1/16", `{ private = 24, public = 24, database = 24 }`),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*CIDR[\s\n]*block`),
+			},
+			{
+				Config:      testCIDRSubnetsForAZsFunctionConfig("10.0.0.0/24", `{ private = 25, public = 25, database = 25 }`),
+				ExpectError: regexache.MustCompile(`not[\s\n]*enough[\s\n]*addresses`),
+			},
+			{
+				Config:      testCIDRSubnetsForAZsFunctionConfig("10.0.0.0/16", `{ private = 24, public = 24, database = 30 }`),
+				ExpectError: regexache.MustCompile(`must[\s\n]*be[\s\n]*between[\s\n]*16[\s\n]*and[\s\n]*28`),
+			},
+			{
+				Config:      testCIDRSubnetsForAZsFunctionConfig("2600:1f14:abcd:ef00::/56", `{ private = 60, public = 64, database = 64 }`),
+				ExpectError: regexache.MustCompile(`must[\s\n]*be[\s\n]*64`),
+			},
+		},
+	})
+}
+
+func testCIDRSubnetsForAZsFunctionConfig(cidrBlock, prefixLengths string) string {
+	return fmt.Sprintf(`
+locals {
+  subnets = provider::aws::cidr_subnets_for_azs(%[1]q, ["usw2-az1", "usw2-az2"], %[2]s)
+}
+
+output "private_az1" {
+  value = local.subnets["private"]["usw2-az1"]
+}
+
+output "private_az2" {
+  value = local.subnets["private"]["usw2-az2"]
+}
+
+output "public_az1" {
+  value = local.subnets["public"]["usw2-az1"]
+}
+
+output "public_az2" {
+  value = local.subnets["public"]["usw2-az2"]
+}
+
+output "database_az1" {
+  value = local.subnets["database"]["usw2-az1"]
+}
+
+output "database_az2" {
+  value = local.subnets["database"]["usw2-az2"]
+}
+`, cidrBlock, prefixLengths)
+}
@@ -0,0 +1,285 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	stringListType = types.ListType{ElemType: types.StringType}
+
+	policyParseStatementAttrTypes = map[string]attr.Type{
+		"sid":           types.StringType,
+		"effect":        types.StringType,
+		"principal":     types.MapType{ElemType: stringListType},
+		"not_principal": types.MapType{ElemType: stringListType},
+		"action":        stringListType,
+		"not_action":    stringListType,
+		"resource":      stringListType,
+		"not_resource":  stringListType,
+		"condition":     types.MapType{ElemType: types.MapType{ElemType: stringListType}},
+	}
+
+	policyParseResultAttrTypes = map[string]attr.Type{
+		"version":   types.StringType,
+		"id":        types.StringType,
+		"statement": types.ListType{ElemType: types.ObjectType{AttrTypes: policyParseStatementAttrTypes}},
+	}
+)
+
+var _ function.Function = policyParseFunction{}
+
+func NewPolicyParseFunction() function.Function {
+	return &policyParseFunction{}
+}
+
+type policyParseFunction struct{}
+
+func (f policyParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_parse"
+}
+
+func (f policyParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "policy_parse Function",
+		MarkdownDescription: "Parses an IAM policy document into an object. Action, resource, principal and " +
+			"condition values are always returned as lists, whether written as a single string or a list.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: policyParseResultAttrTypes,
+		},
+	}
+}
+
+func (f policyParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	policy, err := parsePolicy(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result, d := types.ObjectValueFrom(ctx, policyParseResultAttrTypes, policy)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+type policyDocument struct {
+	Version   string            `tfsdk:"version"`
+	ID        string            `tfsdk:"id"`
+	Statement []policyStatement `tfsdk:"statement"`
+}
+
+type policyStatement struct {
+	Sid          string                         `tfsdk:"sid"`
+	Effect       string                         `tfsdk:"effect"`
+	Principal    map[string][]string            `tfsdk:"principal"`
+	NotPrincipal map[string][]string            `tfsdk:"not_principal"`
+	Action       []string                       `tfsdk:"action"`
+	NotAction    []string                       `tfsdk:"not_action"`
+	Resource     []string                       `tfsdk:"resource"`
+	NotResource  []string                       `tfsdk:"not_resource"`
+	Condition    map[string]map[string][]string `tfsdk:"condition"`
+}
+
+// parsePolicy parses an IAM policy document.
+// Elements that are absent are returned as empty strings, lists and maps.
+func parsePolicy(s string) (policyDocument, error) {
+	var policy policyDocument
+
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+
+	var raw struct {
+		Version   string          `json:"Version"`
+		ID        string          `json:"Id"`
+		Statement json.RawMessage `json:"Statement"`
+	}
+	if err := decoder.Decode(&raw); err != nil {
+		return policy, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	policy.Version = raw.Version
+	policy.ID = raw.ID
+
+	var rawStatements []map[string]any
+	switch statement := strings.TrimSpace(string(raw.Statement)); {
+	case statement == "" || statement == "null":
+		return policy, errors.New("parsing policy: Statement is required")
+	case strings.HasPrefix(statement, "{"):
+		var v map[string]any
+		if err := unmarshalUseNumber(raw.Statement, &v); err != nil {
+			return policy, fmt.Errorf("parsing policy Statement: %w", err)
+		}
+		rawStatements = append(rawStatements, v)
+	default:
+		if err := unmarshalUseNumber(raw.Statement, &rawStatements); err != nil {
+			return policy, fmt.Errorf("parsing policy Statement: %w", err)
+		}
+	}
+
+	policy.Statement = make([]policyStatement, 0, len(rawStatements))
+	for i, v := range rawStatements {
+		statement, err := parsePolicyStatement(v)
+		if err != nil {
+			return policy, fmt.Errorf("parsing policy Statement[%d]: %w", i, err)
+		}
+		policy.Statement = append(policy.Statement, statement)
+	}
+
+	return policy, nil
+}
+
+func parsePolicyStatement(tfMap map[string]any) (policyStatement, error) {
+	var statement policyStatement
+	var err error
+
+	if statement.Sid, err = policyString(tfMap["Sid"]); err != nil {
+		return statement, fmt.Errorf("invalid Sid: %w", err)
+	}
+	if statement.Effect, err = policyString(tfMap["Effect"]); err != nil {
+		return statement, fmt.Errorf("invalid Effect: %w", err)
+	}
+	if statement.Principal, err = policyPrincipal(tfMap["Principal"]); err != nil {
+		return statement, fmt.Errorf("invalid Principal: %w", err)
+	}
+	if statement.NotPrincipal, err = policyPrincipal(tfMap["NotPrincipal"]); err != nil {
+		return statement, fmt.Errorf("invalid NotPrincipal: %w", err)
+	}
+	if statement.Action, err = policyStringList(tfMap["Action"]); err != nil {
+		return statement, fmt.Errorf("invalid Action: %w", err)
+	}
+	if statement.NotAction, err = policyStringList(tfMap["NotAction"]); err != nil {
+		return statement, fmt.Errorf("invalid NotAction: %w", err)
+	}
+	if statement.Resource, err = policyStringList(tfMap["Resource"]); err != nil {
+		return statement, fmt.Errorf("invalid Resource: %w", err)
+	}
+	if statement.NotResource, err = policyStringList(tfMap["NotResource"]); err != nil {
+		return statement, fmt.Errorf("invalid NotResource: %w", err)
+	}
+
+	statement.Condition = make(map[string]map[string][]string)
+	if v, ok := tfMap["Condition"]; ok && v != nil {
+		operators, ok := v.(map[string]any)
+		if !ok {
+			return statement, fmt.Errorf("invalid Condition: expected object, got %T", v)
+		}
+
+		for operator, v := range operators {
+			keys, ok := v.(map[string]any)
+			if !ok {
+				return statement, fmt.Errorf("invalid Condition %s: expected object, got %T", operator, v)
+			}
+
+			statement.Condition[operator] = make(map[string][]string, len(keys))
+			for key, v := range keys {
+				if statement.Condition[operator][key], err = policyStringList(v); err != nil {
+					return statement, fmt.Errorf("invalid Condition %s %s: %w", operator, key, err)
+				}
+			}
+		}
+	}
+
+	return statement, nil
+}
+
+// policyPrincipal returns a principal element as a map of principal type to identifiers.
+// "*" is returned as {"AWS": ["*"]}, which is equivalent.
+func policyPrincipal(v any) (map[string][]string, error) {
+	principal := make(map[string][]string)
+
+	switch v := v.(type) {
+	case nil:
+	case string:
+		if v != "*" {
+			return nil, fmt.Errorf(`expected "*" or object, got %q`, v)
+		}
+		principal["AWS"] = []string{v}
+	case map[string]any:
+		for k, v := range v {
+			identifiers, err := policyStringList(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			principal[k] = identifiers
+		}
+	default:
+		return nil, fmt.Errorf(`expected "*" or object, got %T`, v)
+	}
+
+	return principal, nil
+}
+
+// policyStringList returns a string or list element as a list of strings.
+func policyStringList(v any) ([]string, error) {
+	switch v := v.(type) {
+	case nil:
+		return []string{}, nil
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, v := range v {
+			s, err := policyString(v)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, s)
+		}
+		return values, nil
+	default:
+		s, err := policyString(v)
+		if err != nil {
+			return nil, err
+		}
+		return []string{s}, nil
+	}
+}
+
+// policyString returns a scalar element as a string.
+// Numbers and booleans, which are valid condition values, are converted to their JSON representation.
+func policyString(v any) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	case bool:
+		return fmt.Sprintf("%t", v), nil
+	default:
+		return "", fmt.Errorf("expected string, got %T", v)
+	}
+}
+
+func unmarshalUseNumber(data []byte, v any) error {
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	decoder.UseNumber()
+
+	return decoder.Decode(v)
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+const testPolicyParseFunctionPolicy = `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Sid": "AllowGetObject",
+    "Effect": "Allow",
+    "Principal": "*",
+    "Action": "s3:GetObject",
+    "Resource": ["arn:aws:s3:::example/*"],
+    "Condition": {
+      "Bool": {"aws:SecureTransport": true}
+    }
+  }
+}`
+
+func TestPolicyParseFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testPolicyParseFunctionConfig(testPolicyParseFunctionPolicy),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("version", "2012-10-17"),
+					resource.TestCheckOutput("sid", "AllowGetObject"),
+					resource.TestCheckOutput("action", "s3:GetObject"),
+					resource.TestCheckOutput("not_action_count", "0"),
+					resource.TestCheckOutput("principal", "*"),
+					resource.TestCheckOutput("condition", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestPolicyParseFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testPolicyParseFunctionConfig(`{"Version": "2012-10-17"}`),
+				ExpectError: regexache.MustCompile(`Statement[\s\n]*is[\s\n]*required`),
+			},
+			{
+				Config:      testPolicyParseFunctionConfig(`{"Statement": [{"Action": {"s3": "GetObject"}}]}`),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*Action`),
+			},
+		},
+	})
+}
+
+func testPolicyParseFunctionConfig(policy string) string {
+	return fmt.Sprintf(`
+locals {
+  policy = provider::aws::policy_parse(%[1]q)
+}
+
+output "version" {
+  value = local.policy.version
+}
+
+output "sid" {
+  value = local.policy.statement[0].sid
+}
+
+output "action" {
+  value = local.policy.statement[0].action[0]
+}
+
+output "not_action_count" {
+  value = length(local.policy.statement[0].not_action)
+}
+
+output "principal" {
+  value = local.policy.statement[0].principal["AWS"][0]
+}
+
+output "condition" {
+  value = local.policy.statement[0].condition["Bool"]["aws:SecureTransport"][0]
+}
+`, policy)
+}
@@ -238,6 +238,7 @@ type ResourceDatum struct {
 	CustomImport                      bool
 	goImports                         []common.GoImport
 	HasIdentityFix                    bool
+	ConcurrencyLimit                  int
 	common.ResourceIdentity
 	tests.CommonArgs
 }
@@ -439,6 +440,19 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			case "IdentityFix":
 				d.HasIdentityFix = true
 
+			case "ConcurrencyLimit":
+				if len(args.Positional) != 1 {
+					v.errs = append(v.errs, fmt.Errorf("ConcurrencyLimit missing required parameter: at %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					attr := args.Positional[0]
+					if n, err := strconv.Atoi(attr); err != nil || n < 1 {
+						v.errs = append(v.errs, fmt.Errorf("invalid ConcurrencyLimit value: %q at %s. Should be a positive integer.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+						continue
+					} else {
+						d.ConcurrencyLimit = n
+					}
+				}
+
 			// Needed to validate `hasNoPreExistingResource`, `preIdentityVersion`, and `identityVersion`
 			// TODO: These fields should be moved out of `@Testing`
 			case "Testing":
@@ -738,7 +752,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.sdkListResources[typeName] = d
 				}
 
-			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "Tags", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "NoImport", "CustomImport", "IdentityVersion", "CustomInherentRegionIdentity":
+			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "Tags", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "NoImport", "CustomImport", "IdentityVersion", "CustomInherentRegionIdentity", "ConcurrencyLimit":
 				// Handled above.
 			case "ArnFormat", "IdAttrFormat", "Testing":
 				// Ignored.
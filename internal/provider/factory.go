@@ -34,7 +34,7 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 	}
 
 	servers := []func() tfprotov5.ProviderServer{
-		primary.GRPCProvider,
+		sdkv2.NewProtocol5(primary),
 		providerserver.NewProtocol5(secondary),
 	}
 
@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+)
+
+// concurrencyLimitInterceptor limits the number of concurrent Create, Update and Delete operations for a resource type.
+// It must be the last interceptor in the chain so that the Finally release is always paired with a successful acquire.
+type concurrencyLimitInterceptor struct {
+	resourceNoOpCRUDInterceptor
+	interceptors.HConcurrency
+}
+
+func resourceConcurrencyLimit(defaultLimit int) resourceCRUDInterceptor {
+	return &concurrencyLimitInterceptor{
+		HConcurrency: interceptors.HConcurrency(defaultLimit),
+	}
+}
+
+func (r concurrencyLimitInterceptor) create(ctx context.Context, opts interceptorOptions[resource.CreateRequest, resource.CreateResponse]) {
+	r.run(ctx, opts.c, opts.when, &opts.response.Diagnostics)
+}
+
+func (r concurrencyLimitInterceptor) update(ctx context.Context, opts interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]) {
+	r.run(ctx, opts.c, opts.when, &opts.response.Diagnostics)
+}
+
+func (r concurrencyLimitInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) {
+	r.run(ctx, opts.c, opts.when, &opts.response.Diagnostics)
+}
+
+func (r concurrencyLimitInterceptor) run(ctx context.Context, c awsClient, when when, diags *diag.Diagnostics) {
+	switch when {
+	case Before:
+		if err := r.Acquire(ctx, c); err != nil {
+			diags.AddError("Waiting for resource concurrency limit", err.Error())
+		}
+	case Finally:
+		r.Release(ctx, c)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 )
@@ -433,10 +435,22 @@ func (c mockClient) ServicePackage(_ context.Context, name string) conns.Service
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) AccessAnalyzerClient(ctx context.Context) *accessanalyzer.Client {
+	panic("not implemented") //lintignore:R009
+}
+
+func (c mockClient) PolicyValidation(ctx context.Context) string {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig {
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) ResourceConcurrencySemaphore(ctx context.Context, typeName string, defaultLimit int) *tfsync.Semaphore {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) ValidateInContextRegionInPartition(ctx context.Context) error {
 	panic("not implemented") //lintignore:R009
 }
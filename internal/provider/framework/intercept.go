@@ -8,6 +8,7 @@ import (
 	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -17,15 +18,19 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfiter "github.com/hashicorp/terraform-provider-aws/internal/iter"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 type awsClient interface {
+	AccessAnalyzerClient(context.Context) *accessanalyzer.Client
 	AccountID(context.Context) string
 	Region(context.Context) string
+	ResourceConcurrencySemaphore(ctx context.Context, typeName string, defaultLimit int) *tfsync.Semaphore
 	DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig
 	IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig
 	Partition(context.Context) string
+	PolicyValidation(context.Context) string
 	ServicePackage(_ context.Context, name string) conns.ServicePackage
 	TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig
 	ValidateInContextRegionInPartition(ctx context.Context) error
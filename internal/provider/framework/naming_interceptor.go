@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// resourceValidateNamingConvention validates configured names and name prefixes, and the prefix of generated names,
+// against the provider's naming convention.
+func resourceValidateNamingConvention() resourceModifyPlanInterceptor {
+	return &resourceValidateNamingConventionInterceptor{}
+}
+
+type resourceValidateNamingConventionInterceptor struct{}
+
+func (r resourceValidateNamingConventionInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	nc, ok := create.NamingConventionFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		// If the entire plan is null, the resource is planned for destruction.
+		if request.Plan.Raw.IsNull() {
+			return
+		}
+
+		attributes := request.Config.Schema.GetAttributes()
+		if _, ok := attributes[names.AttrName]; !ok {
+			return
+		}
+		if _, ok := attributes[names.AttrNamePrefix]; !ok {
+			return
+		}
+
+		var name, namePrefix types.String
+		response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrName), &name)...)
+		response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrNamePrefix), &namePrefix)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if name.IsUnknown() || namePrefix.IsUnknown() {
+			return
+		}
+
+		isCreate := request.State.Raw.IsNull()
+		if !isCreate {
+			var stateName, stateNamePrefix types.String
+			response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrName), &stateName)...)
+			response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrNamePrefix), &stateNamePrefix)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			if (name.IsNull() || name.Equal(stateName)) && (namePrefix.IsNull() || namePrefix.Equal(stateNamePrefix)) {
+				return
+			}
+		}
+
+		const summary = "Naming Convention Violation"
+
+		switch {
+		case name.ValueString() != "":
+			if err := nc.ValidateName(name.ValueString()); err != nil {
+				response.Diagnostics.AddAttributeError(path.Root(names.AttrName), summary, err.Error())
+			}
+		case namePrefix.ValueString() != "":
+			if err := nc.ValidateNamePrefix(namePrefix.ValueString()); err != nil {
+				response.Diagnostics.AddAttributeError(path.Root(names.AttrNamePrefix), summary, err.Error())
+			}
+		case isCreate:
+			if err := nc.ValidateNamePrefix(""); err != nil {
+				response.Diagnostics.AddAttributeError(path.Root(names.AttrName), summary, err.Error())
+			}
+		}
+	}
+}
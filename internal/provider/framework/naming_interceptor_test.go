@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func Test_resourceValidateNamingConventionInterceptor(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+
+	namingConvention := &create.NamingConvention{
+		Prefix:       "prod-",
+		MaxLength:    create.UniqueIDSuffixLength + 10,
+		CharacterSet: "[a-z0-9-]",
+	}
+
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrNamePrefix: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+
+	rawVal := func(name, namePrefix any) tftypes.Value {
+		return tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			names.AttrName:       tftypes.NewValue(tftypes.String, name),
+			names.AttrNamePrefix: tftypes.NewValue(tftypes.String, namePrefix),
+		})
+	}
+
+	tests := []struct {
+		name      string
+		nc        *create.NamingConvention
+		config    tftypes.Value
+		state     tftypes.Value
+		wantDiags diag.Diagnostics
+	}{
+		{
+			name:   "compliant name",
+			config: rawVal("prod-orders", nil),
+		},
+		{
+			name:   "noncompliant name",
+			config: rawVal("dev-orders", nil),
+			wantDiags: diag.Diagnostics{diag.NewAttributeErrorDiagnostic(
+				path.Root(names.AttrName),
+				"Naming Convention Violation",
+				`name "dev-orders" must begin with "prod-"`,
+			)},
+		},
+		{
+			name:   "compliant name prefix",
+			config: rawVal(nil, "prod-app-"),
+		},
+		{
+			name:   "noncompliant name prefix",
+			config: rawVal(nil, "prod_app-"),
+			wantDiags: diag.Diagnostics{diag.NewAttributeErrorDiagnostic(
+				path.Root(names.AttrNamePrefix),
+				"Naming Convention Violation",
+				`name prefix "prod_app-" must only contain characters matching [a-z0-9-]`,
+			)},
+		},
+		{
+			name:   "generated name",
+			config: rawVal(nil, nil),
+		},
+		{
+			name: "generated name too long",
+			nc: &create.NamingConvention{
+				Prefix:    "prod-us-west-2-", //lintignore:AWSAT003
+				MaxLength: 32,
+			},
+			config: rawVal(nil, nil),
+			wantDiags: diag.Diagnostics{diag.NewAttributeErrorDiagnostic(
+				path.Root(names.AttrName),
+				"Naming Convention Violation",
+				fmt.Sprintf(`name prefix "prod-us-west-2-" must be at most 6 characters long to allow for the %d character unique suffix of generated names`, create.UniqueIDSuffixLength), //lintignore:AWSAT003
+			)},
+		},
+		{
+			name:   "unknown name",
+			config: rawVal(tftypes.UnknownValue, nil),
+		},
+		{
+			name:   "existing noncompliant name unchanged",
+			config: rawVal("dev-orders", nil),
+			state:  rawVal("dev-orders", ""),
+		},
+		{
+			name:   "existing name changed",
+			config: rawVal("dev-orders", nil),
+			state:  rawVal("dev-order", ""),
+			wantDiags: diag.Diagnostics{diag.NewAttributeErrorDiagnostic(
+				path.Root(names.AttrName),
+				"Naming Convention Violation",
+				`name "dev-orders" must begin with "prod-"`,
+			)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			nc := tt.nc
+			if nc == nil {
+				nc = namingConvention
+			}
+
+			state := tt.state
+			if state.Type() == nil {
+				state = tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil) // Raw state is null on creation
+			}
+
+			opts := interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				request: &resource.ModifyPlanRequest{
+					Config: tfsdk.Config{
+						Raw:    tt.config,
+						Schema: resourceSchema,
+					},
+					State: tfsdk.State{
+						Raw:    state,
+						Schema: resourceSchema,
+					},
+					Plan: tfsdk.Plan{
+						Raw:    tt.config,
+						Schema: resourceSchema,
+					},
+				},
+				response: &resource.ModifyPlanResponse{
+					Plan: tfsdk.Plan{
+						Raw:    tt.config,
+						Schema: resourceSchema,
+					},
+				},
+				when: Before,
+			}
+
+			ctx := create.NewNamingContext(ctx, nc)
+			resourceValidateNamingConvention().modifyPlan(ctx, opts)
+
+			if !opts.response.Diagnostics.Equal(tt.wantDiags) {
+				t.Errorf("response diagnostics not equal. got: %s want: %s", opts.response.Diagnostics, tt.wantDiags)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+)
+
+// resourceValidatePolicyDocuments validates new and changed policy documents with IAM Access Analyzer.
+func resourceValidatePolicyDocuments() resourceModifyPlanInterceptor {
+	return &resourceValidatePolicyDocumentsInterceptor{}
+}
+
+type resourceValidatePolicyDocumentsInterceptor struct{}
+
+func (r resourceValidatePolicyDocumentsInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c := opts.c
+
+	severity := c.PolicyValidation(ctx)
+	if severity == "" {
+		return
+	}
+
+	_, _, _, typeName, _, ok := interceptors.InfoFromContext(ctx, c) //nolint:dogsled // legitimate use as-is, signature to be refactored
+	if !ok {
+		return
+	}
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		// If the entire plan is null, the resource is planned for destruction.
+		if request.Plan.Raw.IsNull() {
+			return
+		}
+
+		s, ok := request.Plan.Schema.(schema.Schema)
+		if !ok {
+			return
+		}
+
+		attributes := policyDocumentAttributes(s)
+		for _, attributeName := range slices.Sorted(maps.Keys(attributes)) {
+			paths, diags := request.Plan.PathMatches(ctx, attributes[attributeName])
+			response.Diagnostics.Append(diags...)
+			if diags.HasError() {
+				return
+			}
+
+			for _, attributePath := range paths {
+				var planValue fwtypes.IAMPolicy
+				response.Diagnostics.Append(request.Plan.GetAttribute(ctx, attributePath, &planValue)...)
+				if response.Diagnostics.HasError() {
+					return
+				}
+
+				if planValue.IsNull() || planValue.IsUnknown() {
+					continue
+				}
+
+				if !request.State.Raw.IsNull() {
+					var stateValue fwtypes.IAMPolicy
+					// The path may not exist in state, e.g. for a new set element.
+					if diags := request.State.GetAttribute(ctx, attributePath, &stateValue); !diags.HasError() && !stateValue.IsNull() {
+						if equal, _ := planValue.StringSemanticEquals(ctx, stateValue); equal {
+							continue
+						}
+					}
+				}
+
+				findings, err := interceptors.ValidatePolicyDocument(ctx, c, typeName, attributeName, planValue.ValueString())
+				if err != nil {
+					response.Diagnostics.AddAttributeError(attributePath, "Policy Validation Failed", fmt.Sprintf("validating policy document with IAM Access Analyzer: %s", err))
+					continue
+				}
+
+				for _, finding := range findings {
+					summary := interceptors.PolicyValidationFindingSummary(finding)
+					detail := interceptors.PolicyValidationFindingDetail(finding)
+
+					switch severity {
+					case "warning":
+						response.Diagnostics.AddAttributeWarning(attributePath, summary, detail)
+					default:
+						response.Diagnostics.AddAttributeError(attributePath, summary, detail)
+					}
+				}
+			}
+		}
+	}
+}
+
+// policyDocumentAttributes returns path expressions matching the configurable attributes in the specified schema that hold IAM policy documents.
+// The expressions are keyed by attribute name; nested attribute names are separated by ".".
+func policyDocumentAttributes(s schema.Schema) map[string]path.Expression {
+	expressions := make(map[string]path.Expression)
+
+	walkPolicyDocumentAttributes(s.Attributes, s.Blocks, "", path.Expression{}, expressions)
+
+	return expressions
+}
+
+func walkPolicyDocumentAttributes(attributes map[string]schema.Attribute, blocks map[string]schema.Block, parentName string, parentExpression path.Expression, expressions map[string]path.Expression) {
+	child := func(name string) (string, path.Expression) {
+		if parentName == "" {
+			return name, path.MatchRoot(name)
+		}
+		return parentName + "." + name, parentExpression.AtName(name)
+	}
+
+	for name, attribute := range attributes {
+		attributeName, expression := child(name)
+
+		switch attribute := attribute.(type) {
+		case schema.ListNestedAttribute:
+			walkPolicyDocumentAttributes(attribute.NestedObject.Attributes, nil, attributeName, expression.AtAnyListIndex(), expressions)
+		case schema.MapNestedAttribute:
+			walkPolicyDocumentAttributes(attribute.NestedObject.Attributes, nil, attributeName, expression.AtAnyMapKey(), expressions)
+		case schema.SetNestedAttribute:
+			walkPolicyDocumentAttributes(attribute.NestedObject.Attributes, nil, attributeName, expression.AtAnySetValue(), expressions)
+		case schema.SingleNestedAttribute:
+			walkPolicyDocumentAttributes(attribute.Attributes, nil, attributeName, expression, expressions)
+		default:
+			if (attribute.IsOptional() || attribute.IsRequired()) && fwtypes.IAMPolicyType.Equal(attribute.GetType()) {
+				expressions[attributeName] = expression
+			}
+		}
+	}
+
+	for name, block := range blocks {
+		attributeName, expression := child(name)
+
+		switch block := block.(type) {
+		case schema.ListNestedBlock:
+			walkPolicyDocumentAttributes(block.NestedObject.Attributes, block.NestedObject.Blocks, attributeName, expression.AtAnyListIndex(), expressions)
+		case schema.SetNestedBlock:
+			walkPolicyDocumentAttributes(block.NestedObject.Attributes, block.NestedObject.Blocks, attributeName, expression.AtAnySetValue(), expressions)
+		case schema.SingleNestedBlock:
+			walkPolicyDocumentAttributes(block.Attributes, block.Blocks, attributeName, expression, expressions)
+		}
+	}
+}
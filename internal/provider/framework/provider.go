@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
@@ -82,6 +83,12 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"api_call_report": schema.StringAttribute{
+				Optional: true,
+				Description: "Path of a local file to which a JSON summary of the AWS API calls made by each provider process is written when the process exits. " +
+					"The process ID is inserted before the file extension, e.g. `api-calls.json` becomes `api-calls.1234.json`. " +
+					"Calls are grouped by resource type and operation, with call, retry and throttle counts and p50/p95 latencies.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -118,6 +125,14 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"policy_validation": schema.StringAttribute{
+				Optional: true,
+				Description: `The severity with which to report IAM Access Analyzer policy validation findings for policy documents in resources managed by this provider instance. ` +
+					`Policy documents are validated during plan and ERROR and SECURITY_WARNING findings are reported. ` +
+					`Valid values are "error", "warning", and "disabled". ` +
+					`When unset or "disabled", policy documents will not be validated by the provider. ` +
+					`Can also be configured with the ` + interceptors.PolicyValidationEnvVar + ` environment variable.`,
+			},
 			"profile": schema.StringAttribute{
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
@@ -126,6 +141,11 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
 			},
+			"resource_concurrency_limits": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "Maximum number of concurrent create, update and delete operations per resource type, e.g. `aws_route53_record`. Overrides the resource type's default limit; `0` removes the limit.",
+			},
 			"retry_mode": schema.StringAttribute{
 				Optional:    true,
 				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. Can also be configured using the `AWS_RETRY_MODE` environment variable.",
@@ -177,11 +197,16 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 			"tag_policy_compliance": schema.StringAttribute{
 				Optional: true,
 				Description: `The severity with which to enforce organizational tagging policies on resources managed by this provider instance. ` +
-					`At this time this only includes compliance with required tag keys by resource type. ` +
+					`This includes compliance with required tag keys by resource type, tag key capitalization and allowed tag values. ` +
 					`Valid values are "error", "warning", and "disabled". ` +
 					`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 					`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
 			},
+			"tag_policy_file": schema.StringAttribute{
+				Optional: true,
+				Description: `The path to a local JSON tag policy document to enforce instead of the effective tag policy of the account. ` +
+					`Only used when tag policy compliance is enabled.`,
+			},
 			"token": schema.StringAttribute{
 				Optional:    true,
 				Description: "session token. A session token is only required if you are\nusing temporary security credentials.",
@@ -327,6 +352,80 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"naming": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with a naming convention for the `name` and `name_prefix` arguments of resources and for names generated by the provider.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"character_set": schema.StringAttribute{
+							Optional:    true,
+							Description: "A regular expression matching a single allowed character of resource names, e.g. `[a-z0-9-]`.",
+						},
+						"env": schema.StringAttribute{
+							Optional:    true,
+							Description: "The value of the `{env}` token in name prefixes.",
+						},
+						"max_length": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum length of resource names.",
+						},
+						"prefix": schema.StringAttribute{
+							Optional:    true,
+							Description: "The prefix that resource names must begin with, also used instead of the default prefix of generated names. May contain the `{region}`, `{account_id}` and `{env}` tokens.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"resource_type": schema.ListNestedBlock{
+							Description: "Configuration blocks with naming policies for individual resource types, overriding the provider-wide naming policy.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"character_set": schema.StringAttribute{
+										Optional:    true,
+										Description: "A regular expression matching a single allowed character of resource names, e.g. `[a-z0-9-]`.",
+									},
+									"max_length": schema.Int64Attribute{
+										Optional:    true,
+										Description: "The maximum length of resource names.",
+									},
+									"prefix": schema.StringAttribute{
+										Optional:    true,
+										Description: "The prefix that resource names must begin with, also used instead of the default prefix of generated names. May contain the `{region}`, `{account_id}` and `{env}` tokens.",
+									},
+									"type": schema.StringAttribute{
+										Required:    true,
+										Description: "The resource type name, e.g. `aws_sqs_queue`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"service_rate_limits": schema.ListNestedBlock{
+				Description: "Configuration blocks with client-side rate limits for individual AWS services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of requests that may be made at once before `requests_per_second` applies. Defaults to 1.",
+						},
+						"max_concurrency": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of in-flight requests to the service.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The sustained rate of requests to the service.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service to rate limit, using the same names as the `endpoints` block, e.g. `iam` or `route53`.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -397,6 +496,9 @@ func (p *frameworkProvider) Functions(_ context.Context) []func() function.Funct
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRSubnetsForAZsFunction,
+		tffunction.NewPolicyNormalizeFunction,
+		tffunction.NewPolicyParseFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 		tffunction.NewUserAgentFunction,
 	}
@@ -7,9 +7,11 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 	"unique"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -263,7 +265,8 @@ func resourceTransparentTagging(servicePackageResourceTags unique.Handle[inttype
 	}
 }
 
-// resourceValidateRequiredTags validates that required tags are present for a given resource type.
+// resourceValidateRequiredTags validates that required tags are present for a given resource type
+// and that tags comply with the tag key capitalization and allowed tag value rules of the tag policy.
 func resourceValidateRequiredTags() resourceModifyPlanInterceptor {
 	return &resourceValidateRequiredTagsInterceptor{}
 }
@@ -282,8 +285,8 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 	if policy == nil {
 		return
 	}
-	reqTags, ok := policy.RequiredTags[typeName]
-	if !ok {
+	reqTags := policy.RequiredTags[typeName]
+	if len(reqTags) == 0 && len(policy.TagRules[typeName]) == 0 {
 		return
 	}
 
@@ -315,21 +318,31 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 			return
 		}
 
-		if allPlanTags.ContainsAllKeys(reqTags) {
-			return
-		}
+		if !allPlanTags.ContainsAllKeys(reqTags) {
+			missing := reqTags.Removed(allPlanTags).Keys()
+			slices.Sort(missing)
+
+			summary := "Missing Required Tags"
+			detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
 
-		missing := reqTags.Removed(allPlanTags).Keys()
-		slices.Sort(missing)
+			addTagPolicyDiagnostic(&opts.response.Diagnostics, policy.Severity, summary, detail)
+		}
 
-		summary := "Missing Required Tags"
-		detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
+		if noncompliant := policy.NoncompliantTags(typeName, allPlanTags); len(noncompliant) > 0 {
+			summary := "Noncompliant Tags"
+			detail := fmt.Sprintf("An organizational tag policy does not allow the following tags for %s: %s", typeName, strings.Join(noncompliant, "; "))
 
-		switch policy.Severity {
-		case "warning":
-			opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
-		default:
-			opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+			addTagPolicyDiagnostic(&opts.response.Diagnostics, policy.Severity, summary, detail)
 		}
 	}
 }
+
+// addTagPolicyDiagnostic adds a tag policy violation diagnostic with the specified severity.
+func addTagPolicyDiagnostic(diags *diag.Diagnostics, severity, summary, detail string) {
+	switch severity {
+	case "warning":
+		diags.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
+	default:
+		diags.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+	}
+}
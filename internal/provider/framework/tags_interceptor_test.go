@@ -47,6 +47,24 @@ func (c mockRequiredTagsClient) TagPolicyConfig(ctx context.Context) *tftags.Tag
 	}
 }
 
+type mockTagRulesClient struct {
+	mockRequiredTagsClient
+}
+
+func (c mockTagRulesClient) TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig {
+	return &tftags.TagPolicyConfig{
+		Severity: "warning",
+		TagRules: map[string]map[string]tftags.TagPolicyRule{
+			"aws_test": {
+				"costcenter": {
+					Key:    "CostCenter",
+					Values: []string{"100", "200*"},
+				},
+			},
+		},
+	}
+}
+
 type mockServicePackage struct{}
 
 func (sp mockServicePackage) FrameworkDataSources(context.Context) []*inttypes.ServicePackageFrameworkDataSource {
@@ -409,3 +427,116 @@ func Test_resourceValidateRequiredTagsInterceptor(t *testing.T) {
 		})
 	}
 }
+
+func Test_resourceValidateRequiredTagsInterceptor_tagRules(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		ctx = conns.NewResourceContext(ctx, "Test", "test", "aws_test", "")
+		if v, ok := meta.(awsClient); ok {
+			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx), v.TagPolicyConfig(ctx))
+		}
+
+		return ctx
+	}
+
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"tags": tftags.TagsAttribute(),
+		},
+	}
+
+	rawValWithTags := func(tags map[string]string) tftypes.Value {
+		values := make(map[string]tftypes.Value, len(tags))
+		for k, v := range tags {
+			values[k] = tftypes.NewValue(tftypes.String, v)
+		}
+
+		return tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "test"),
+			"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, values),
+		})
+	}
+
+	tests := []struct {
+		name      string
+		tags      map[string]string
+		wantDiags diag.Diagnostics
+	}{
+		{
+			name: "compliant",
+			tags: map[string]string{
+				"CostCenter": "200-finance",
+				"Other":      "value",
+			},
+		},
+		{
+			name: "key capitalization",
+			tags: map[string]string{
+				"costcenter": "100",
+			},
+			wantDiags: diag.Diagnostics{diag.NewAttributeWarningDiagnostic(
+				path.Root(names.AttrTags),
+				"Noncompliant Tags",
+				`An organizational tag policy does not allow the following tags for aws_test: tag key "costcenter" must be capitalized as "CostCenter"`,
+			),
+			},
+		},
+		{
+			name: "allowed values",
+			tags: map[string]string{
+				"CostCenter": "300",
+			},
+			wantDiags: diag.Diagnostics{diag.NewAttributeWarningDiagnostic(
+				path.Root(names.AttrTags),
+				"Noncompliant Tags",
+				`An organizational tag policy does not allow the following tags for aws_test: tag "CostCenter" value "300" is not one of the allowed values: ["100" "200*"]`,
+			),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rawVal := rawValWithTags(tt.tags)
+			opts := interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c: mockTagRulesClient{},
+				request: &resource.ModifyPlanRequest{
+					Config: tfsdk.Config{
+						Raw:    rawVal,
+						Schema: resourceSchema,
+					},
+					State: tfsdk.State{
+						Raw:    tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil), // Raw state is null on creation
+						Schema: resourceSchema,
+					},
+					Plan: tfsdk.Plan{
+						Raw:    rawVal,
+						Schema: resourceSchema,
+					},
+				},
+				response: &resource.ModifyPlanResponse{
+					Plan: tfsdk.Plan{
+						Raw:    rawVal,
+						Schema: resourceSchema,
+					},
+				},
+				when: Before,
+			}
+
+			r := resourceValidateRequiredTags()
+			ctx := bootstrapContext(ctx, opts.c)
+			r.modifyPlan(ctx, opts)
+
+			if !opts.response.Diagnostics.Equal(tt.wantDiags) {
+				t.Errorf("response diagnostics not equal. got: %s want: %s", opts.response.Diagnostics, tt.wantDiags)
+			}
+		})
+	}
+}
@@ -560,9 +560,15 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 		interceptors = append(interceptors, resourceValidateRequiredTags())
 	}
 
+	interceptors = append(interceptors, resourceValidatePolicyDocuments())
+	interceptors = append(interceptors, resourceValidateNamingConvention())
+
 	inner, _ := spec.Factory(context.TODO())
 
 	if len(spec.Identity.Attributes) == 0 {
+		// Must be the last interceptor.
+		interceptors = append(interceptors, resourceConcurrencyLimit(spec.ConcurrencyLimit))
+
 		return &wrappedResource{
 			inner:              inner,
 			servicePackageName: servicePackageName,
@@ -572,6 +578,8 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 	}
 
 	interceptors = append(interceptors, newIdentityInterceptor(spec.Identity.Attributes))
+	// Must be the last interceptor.
+	interceptors = append(interceptors, resourceConcurrencyLimit(spec.ConcurrencyLimit))
 	if v, ok := inner.(framework.Identityer); ok {
 		v.SetIdentitySpec(spec.Identity)
 	}
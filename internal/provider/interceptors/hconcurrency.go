@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+)
+
+type concurrencyAWSClient interface {
+	ResourceConcurrencySemaphore(ctx context.Context, typeName string, defaultLimit int) *tfsync.Semaphore
+}
+
+// HConcurrency is a resource type's registered default concurrency limit.
+// Zero means unlimited unless overridden in the provider configuration.
+type HConcurrency int
+
+func (h HConcurrency) semaphore(ctx context.Context, c concurrencyAWSClient) *tfsync.Semaphore {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	return c.ResourceConcurrencySemaphore(ctx, inContext.TypeName(), int(h))
+}
+
+// Acquire blocks until the resource type's concurrency limit admits another operation.
+func (h HConcurrency) Acquire(ctx context.Context, c concurrencyAWSClient) error {
+	s := h.semaphore(ctx, c)
+	if s == nil {
+		return nil
+	}
+
+	tflog.Debug(ctx, "Waiting for resource concurrency limit", map[string]any{
+		"tf_aws.concurrency_limit": s.Limit(),
+	})
+
+	return s.Acquire(ctx)
+}
+
+// Release releases a slot acquired by Acquire.
+func (h HConcurrency) Release(ctx context.Context, c concurrencyAWSClient) {
+	if s := h.semaphore(ctx, c); s != nil {
+		s.Release()
+	}
+}
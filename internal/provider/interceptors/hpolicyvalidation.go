@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+)
+
+const (
+	PolicyValidationEnvVar = "TF_AWS_POLICY_VALIDATION"
+)
+
+type policyValidationAWSClient interface {
+	AccessAnalyzerClient(context.Context) *accessanalyzer.Client
+}
+
+type policyDocumentType struct {
+	policyType   awstypes.PolicyType
+	resourceType awstypes.ValidatePolicyResourceType
+}
+
+// policyDocumentTypes are the Access Analyzer policy types of policy document attributes, keyed by resource type name and attribute name.
+// Nested attribute names are separated by ".".
+// Policy document attributes that are not listed are validated as resource-based policies.
+var policyDocumentTypes = map[string]map[string]policyDocumentType{
+	"aws_dynamodb_resource_policy": {
+		"policy": {policyType: awstypes.PolicyTypeResourcePolicy, resourceType: awstypes.ValidatePolicyResourceTypeDynamodbTable},
+	},
+	"aws_eks_pod_identity_association": {
+		"policy": {policyType: awstypes.PolicyTypeIdentityPolicy},
+	},
+	"aws_iam_group_policy": {
+		"policy": {policyType: awstypes.PolicyTypeIdentityPolicy},
+	},
+	"aws_iam_policy": {
+		"policy": {policyType: awstypes.PolicyTypeIdentityPolicy},
+	},
+	"aws_iam_role": {
+		"assume_role_policy":   {policyType: awstypes.PolicyTypeResourcePolicy, resourceType: awstypes.ValidatePolicyResourceTypeRoleTrust},
+		"inline_policy.policy": {policyType: awstypes.PolicyTypeIdentityPolicy},
+	},
+	"aws_iam_role_policy": {
+		"policy": {policyType: awstypes.PolicyTypeIdentityPolicy},
+	},
+	"aws_iam_user_policy": {
+		"policy": {policyType: awstypes.PolicyTypeIdentityPolicy},
+	},
+	"aws_s3_access_point": {
+		"policy": {policyType: awstypes.PolicyTypeResourcePolicy, resourceType: awstypes.ValidatePolicyResourceTypeS3AccessPoint},
+	},
+	"aws_s3_bucket": {
+		"policy": {policyType: awstypes.PolicyTypeResourcePolicy, resourceType: awstypes.ValidatePolicyResourceTypeS3Bucket},
+	},
+	"aws_s3_bucket_policy": {
+		"policy": {policyType: awstypes.PolicyTypeResourcePolicy, resourceType: awstypes.ValidatePolicyResourceTypeS3Bucket},
+	},
+	"aws_s3control_access_point_policy": {
+		"policy": {policyType: awstypes.PolicyTypeResourcePolicy, resourceType: awstypes.ValidatePolicyResourceTypeS3AccessPoint},
+	},
+	"aws_s3control_multi_region_access_point_policy": {
+		"details.policy": {policyType: awstypes.PolicyTypeResourcePolicy, resourceType: awstypes.ValidatePolicyResourceTypeS3MultiRegionAccessPoint},
+	},
+	"aws_s3control_object_lambda_access_point_policy": {
+		"policy": {policyType: awstypes.PolicyTypeResourcePolicy, resourceType: awstypes.ValidatePolicyResourceTypeS3ObjectLambdaAccessPoint},
+	},
+	"aws_ssoadmin_permission_set_inline_policy": {
+		"inline_policy": {policyType: awstypes.PolicyTypeIdentityPolicy},
+	},
+	"aws_transfer_access": {
+		"policy": {policyType: awstypes.PolicyTypeIdentityPolicy},
+	},
+	"aws_transfer_user": {
+		"policy": {policyType: awstypes.PolicyTypeIdentityPolicy},
+	},
+}
+
+// nonIAMPolicyDocuments are the attributes that share the IAM policy document schema
+// but whose documents are written in another policy language.
+var nonIAMPolicyDocuments = map[string][]string{
+	"aws_iot_policy":                       {"policy"},
+	"aws_sns_topic_data_protection_policy": {"policy"},
+}
+
+// ValidatePolicyDocument validates a resource type's policy document attribute value with IAM Access Analyzer.
+// It returns the ERROR and SECURITY_WARNING findings.
+func ValidatePolicyDocument(ctx context.Context, c policyValidationAWSClient, typeName, attributeName, document string) ([]awstypes.ValidatePolicyFinding, error) {
+	if slices.Contains(nonIAMPolicyDocuments[typeName], attributeName) {
+		return nil, nil
+	}
+
+	if v := strings.TrimSpace(document); v == "" || v == "{}" {
+		return nil, nil
+	}
+
+	docType, ok := policyDocumentTypes[typeName][attributeName]
+	if !ok {
+		docType = policyDocumentType{policyType: awstypes.PolicyTypeResourcePolicy}
+	}
+
+	input := accessanalyzer.ValidatePolicyInput{
+		PolicyDocument:             aws.String(document),
+		PolicyType:                 docType.policyType,
+		ValidatePolicyResourceType: docType.resourceType,
+	}
+
+	var findings []awstypes.ValidatePolicyFinding
+	pages := accessanalyzer.NewValidatePolicyPaginator(c.AccessAnalyzerClient(ctx), &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Findings {
+			switch v.FindingType {
+			case awstypes.ValidatePolicyFindingTypeError, awstypes.ValidatePolicyFindingTypeSecurityWarning:
+				findings = append(findings, v)
+			}
+		}
+	}
+
+	return findings, nil
+}
+
+// PolicyValidationFindingSummary returns a diagnostic summary for an IAM Access Analyzer policy validation finding.
+func PolicyValidationFindingSummary(finding awstypes.ValidatePolicyFinding) string {
+	var findingType string
+	switch finding.FindingType {
+	case awstypes.ValidatePolicyFindingTypeError:
+		findingType = "Error"
+	case awstypes.ValidatePolicyFindingTypeSecurityWarning:
+		findingType = "Security Warning"
+	default:
+		findingType = string(finding.FindingType)
+	}
+
+	return fmt.Sprintf("Policy Validation %s (%s)", findingType, aws.ToString(finding.IssueCode))
+}
+
+// PolicyValidationFindingDetail returns a diagnostic detail for an IAM Access Analyzer policy validation finding.
+func PolicyValidationFindingDetail(finding awstypes.ValidatePolicyFinding) string {
+	var sb strings.Builder
+
+	sb.WriteString(aws.ToString(finding.FindingDetails))
+
+	if len(finding.Locations) > 0 {
+		if span := finding.Locations[0].Span; span != nil && span.Start != nil {
+			fmt.Fprintf(&sb, "\n\nLocation: line %d, column %d.", aws.ToInt32(span.Start.Line), aws.ToInt32(span.Start.Column))
+		}
+	}
+
+	if v := aws.ToString(finding.LearnMoreLink); v != "" {
+		fmt.Fprintf(&sb, "\n\nLearn more: %s", v)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+)
+
+// concurrencyLimitInterceptor limits the number of concurrent Create, Update and Delete operations for a resource type.
+// It must be the last Before interceptor in the chain so that the Finally release is always paired with a successful acquire.
+type concurrencyLimitInterceptor struct {
+	interceptors.HConcurrency
+}
+
+func resourceConcurrencyLimit(defaultLimit int) crudInterceptor {
+	return &concurrencyLimitInterceptor{
+		HConcurrency: interceptors.HConcurrency(defaultLimit),
+	}
+}
+
+func (r concurrencyLimitInterceptor) run(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch opts.when {
+	case Before:
+		if err := r.Acquire(ctx, opts.c); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for resource concurrency limit: %s", err)
+		}
+	case Finally:
+		r.Release(ctx, opts.c)
+	}
+
+	return diags
+}
@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NewProtocol5 returns a terraform-plugin-go protocol v5 provider server factory function for the specified Plugin SDKv2 provider.
+// Its servers report the diagnostics added with addPlanDiagnostics while planning a resource change.
+func NewProtocol5(p *schema.Provider) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return &grpcProviderServer{
+			GRPCProviderServer: schema.NewGRPCProviderServer(p),
+		}
+	}
+}
+
+type grpcProviderServer struct {
+	*schema.GRPCProviderServer
+}
+
+func (s *grpcProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	planDiags := &planDiagnostics{}
+	ctx = context.WithValue(ctx, planDiagnosticsKey{}, planDiags)
+
+	response, err := s.GRPCProviderServer.PlanResourceChange(ctx, request)
+	if response != nil {
+		response.Diagnostics = append(response.Diagnostics, planDiags.proto()...)
+	}
+
+	return response, err
+}
+
+type planDiagnosticsKey struct{}
+
+// planDiagnostics are the diagnostics reported while planning a resource change.
+// CustomizeDiff functions can only return an error, which loses warnings and the attribute paths of multiple errors.
+type planDiagnostics struct {
+	mutex sync.Mutex
+	diags diag.Diagnostics
+}
+
+// addPlanDiagnostics adds diagnostics to those reported while planning the current resource change.
+// It returns false if the diagnostics can't be reported, e.g. outside of PlanResourceChange.
+func addPlanDiagnostics(ctx context.Context, diags ...diag.Diagnostic) bool {
+	planDiags, ok := ctx.Value(planDiagnosticsKey{}).(*planDiagnostics)
+	if !ok {
+		return false
+	}
+
+	planDiags.mutex.Lock()
+	defer planDiags.mutex.Unlock()
+
+	planDiags.diags = append(planDiags.diags, diags...)
+
+	return true
+}
+
+func (d *planDiagnostics) proto() []*tfprotov5.Diagnostic {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	var diags []*tfprotov5.Diagnostic
+
+	for _, v := range d.diags {
+		severity := tfprotov5.DiagnosticSeverityError
+		if v.Severity == diag.Warning {
+			severity = tfprotov5.DiagnosticSeverityWarning
+		}
+
+		diags = append(diags, &tfprotov5.Diagnostic{
+			Severity:  severity,
+			Summary:   v.Summary,
+			Detail:    v.Detail,
+			Attribute: attributePathFromPath(v.AttributePath),
+		})
+	}
+
+	return diags
+}
+
+// attributePathFromPath converts a cty path to a protocol attribute path.
+// Set element steps are not supported, and the path up to the first such step is returned.
+func attributePathFromPath(path cty.Path) *tftypes.AttributePath {
+	if len(path) == 0 {
+		return nil
+	}
+
+	attributePath := tftypes.NewAttributePath()
+
+	for _, step := range path {
+		switch step := step.(type) {
+		case cty.GetAttrStep:
+			attributePath = attributePath.WithAttributeName(step.Name)
+		case cty.IndexStep:
+			switch key := step.Key; key.Type() {
+			case cty.String:
+				attributePath = attributePath.WithElementKeyString(key.AsString())
+			case cty.Number:
+				v, _ := key.AsBigFloat().Int64()
+				attributePath = attributePath.WithElementKeyInt(int(v))
+			default:
+				return attributePath
+			}
+		}
+	}
+
+	return attributePath
+}
@@ -8,10 +8,12 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -307,6 +309,14 @@ func (c mockClient) IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig {
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) AccessAnalyzerClient(ctx context.Context) *accessanalyzer.Client {
+	panic("not implemented") //lintignore:R009
+}
+
+func (c mockClient) PolicyValidation(ctx context.Context) string {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig {
 	panic("not implemented") //lintignore:R009
 }
@@ -319,6 +329,10 @@ func (c mockClient) ServicePackage(_ context.Context, name string) conns.Service
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) ResourceConcurrencySemaphore(ctx context.Context, typeName string, defaultLimit int) *tfsync.Semaphore {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) ValidateInContextRegionInPartition(ctx context.Context) error {
 	panic("not implemented") //lintignore:R009
 }
@@ -9,21 +9,26 @@ import (
 	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 type awsClient interface {
+	AccessAnalyzerClient(context.Context) *accessanalyzer.Client
 	AccountID(ctx context.Context) string
 	Region(ctx context.Context) string
+	ResourceConcurrencySemaphore(ctx context.Context, typeName string, defaultLimit int) *tfsync.Semaphore
 	DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig
 	IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig
 	Partition(context.Context) string
+	PolicyValidation(context.Context) string
 	ServicePackage(_ context.Context, name string) conns.ServicePackage
 	TagPolicyConfig(context.Context) *tftags.TagPolicyConfig
 	ValidateInContextRegionInPartition(ctx context.Context) error
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// hasNameAndNamePrefix returns whether the specified schema has configurable `name` and `name_prefix` attributes.
+func hasNameAndNamePrefix(s map[string]*schema.Schema) bool {
+	for _, attributeName := range []string{names.AttrName, names.AttrNamePrefix} {
+		if v, ok := s[attributeName]; !ok || v.Type != schema.TypeString || (!v.Optional && !v.Required) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateNamingConvention validates configured names and name prefixes, and the prefix of generated names,
+// against the provider's naming convention.
+func validateNamingConvention() customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		nc, ok := create.NamingConventionFromContext(ctx)
+		if !ok {
+			return nil
+		}
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				isCreate := d.GetRawState().IsNull()
+				if !isCreate && !d.HasChanges(names.AttrName, names.AttrNamePrefix) {
+					return nil
+				}
+
+				config := d.GetRawConfig()
+				if config.IsNull() {
+					return nil
+				}
+
+				name, namePrefix := config.GetAttr(names.AttrName), config.GetAttr(names.AttrNamePrefix)
+				if !name.IsKnown() || !namePrefix.IsKnown() {
+					return nil
+				}
+
+				switch {
+				case !name.IsNull() && name.AsString() != "":
+					if err := nc.ValidateName(name.AsString()); err != nil {
+						return cty.GetAttrPath(names.AttrName).NewErrorf("Naming Convention Violation - %s", err)
+					}
+				case !namePrefix.IsNull() && namePrefix.AsString() != "":
+					if err := nc.ValidateNamePrefix(namePrefix.AsString()); err != nil {
+						return cty.GetAttrPath(names.AttrNamePrefix).NewErrorf("Naming Convention Violation - %s", err)
+					}
+				case isCreate:
+					if err := nc.ValidateNamePrefix(""); err != nil {
+						return cty.GetAttrPath(names.AttrName).NewErrorf("Naming Convention Violation - %s", err)
+					}
+				}
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// policyDocumentDiffSuppressFuncs are the DiffSuppressFuncs that identify attributes holding IAM policy documents.
+var policyDocumentDiffSuppressFuncs = []schema.SchemaDiffSuppressFunc{
+	sdkv2.SuppressEquivalentIAMPolicyDocuments,
+	verify.SuppressEquivalentPolicyDiffs,
+}
+
+// policyDocumentAttributes returns the names of the configurable attributes in the specified schema that hold IAM policy documents.
+// Nested attribute names are separated by ".".
+func policyDocumentAttributes(s map[string]*schema.Schema) []string {
+	var attributeNames []string
+
+	for name, v := range s {
+		if !v.Optional && !v.Required {
+			continue
+		}
+
+		switch v.Type {
+		case schema.TypeString:
+			if isPolicyDocumentDiffSuppressFunc(v.DiffSuppressFunc) {
+				attributeNames = append(attributeNames, name)
+			}
+		case schema.TypeList, schema.TypeSet:
+			if elem, ok := v.Elem.(*schema.Resource); ok {
+				for _, nestedName := range policyDocumentAttributes(elem.SchemaMap()) {
+					attributeNames = append(attributeNames, name+"."+nestedName)
+				}
+			}
+		}
+	}
+
+	slices.Sort(attributeNames)
+
+	return attributeNames
+}
+
+func isPolicyDocumentDiffSuppressFunc(f schema.SchemaDiffSuppressFunc) bool {
+	if f == nil {
+		return false
+	}
+
+	// Functions are not comparable, so compare their entry points.
+	p := reflect.ValueOf(f).Pointer()
+	return slices.ContainsFunc(policyDocumentDiffSuppressFuncs, func(v schema.SchemaDiffSuppressFunc) bool {
+		return reflect.ValueOf(v).Pointer() == p
+	})
+}
+
+// attributeNameFromPath returns the attribute name, without list or set indices, of the specified path.
+func attributeNameFromPath(path cty.Path) string {
+	var parts []string
+
+	for _, step := range path {
+		if v, ok := step.(cty.GetAttrStep); ok {
+			parts = append(parts, v.Name)
+		}
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// validatePolicyDocuments validates new and changed policy documents with IAM Access Analyzer.
+func validatePolicyDocuments(attributeNames []string) customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		severity := c.PolicyValidation(ctx)
+		if severity == "" {
+			return nil
+		}
+
+		_, _, _, typeName, _, ok := interceptors.InfoFromContext(ctx, c)
+		if !ok {
+			return nil
+		}
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				var diags diag.Diagnostics
+
+				err := cty.Walk(d.GetRawConfig(), func(path cty.Path, v cty.Value) (bool, error) {
+					attributeName := attributeNameFromPath(path)
+					if !slices.Contains(attributeNames, attributeName) {
+						return true, nil
+					}
+
+					if !v.IsKnown() || v.IsNull() || !v.Type().Equals(cty.String) {
+						return false, nil
+					}
+
+					document := v.AsString()
+					if old, err := path.Apply(d.GetRawState()); err == nil && old.IsKnown() && !old.IsNull() && verify.PolicyStringsEquivalent(old.AsString(), document) {
+						return false, nil
+					}
+
+					findings, err := interceptors.ValidatePolicyDocument(ctx, c, typeName, attributeName, document)
+					if err != nil {
+						diags = append(diags, errs.NewAttributeErrorDiagnostic(path, "Policy Validation Failed", fmt.Sprintf("validating policy document with IAM Access Analyzer: %s", err)))
+						return false, nil
+					}
+
+					diags = append(diags, policyValidationDiagnostics(severity, path, findings)...)
+
+					return false, nil
+				})
+				if err != nil {
+					return err
+				}
+
+				return reportPlanDiagnostics(ctx, diags)
+			}
+		}
+
+		return nil
+	})
+}
+
+// policyValidationDiagnostics returns diagnostics for the IAM Access Analyzer policy validation findings of the policy document at the specified path.
+func policyValidationDiagnostics(severity string, path cty.Path, findings []awstypes.ValidatePolicyFinding) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, finding := range findings {
+		summary := interceptors.PolicyValidationFindingSummary(finding)
+		detail := interceptors.PolicyValidationFindingDetail(finding)
+
+		switch severity {
+		case "warning":
+			diags = append(diags, errs.NewAttributeWarningDiagnostic(path, summary, detail))
+		default:
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(path, summary, detail))
+		}
+	}
+
+	return diags
+}
+
+// reportPlanDiagnostics reports diagnostics from a CustomizeDiff interceptor with the plan.
+// If they can't be reported with the plan, warnings are logged and errors are returned, each with its attribute path.
+func reportPlanDiagnostics(ctx context.Context, diags diag.Diagnostics) error {
+	if len(diags) == 0 || addPlanDiagnostics(ctx, diags...) {
+		return nil
+	}
+
+	var errDiags diag.Diagnostics
+
+	for _, d := range diags {
+		switch d.Severity {
+		case diag.Warning:
+			tflog.Warn(ctx, d.Summary, map[string]any{
+				"attribute": attributeNameFromPath(d.AttributePath),
+				"detail":    d.Detail,
+			})
+		default:
+			errDiags = append(errDiags, d)
+		}
+	}
+
+	switch len(errDiags) {
+	case 0:
+		return nil
+	case 1:
+		// A single path error is reported on its attribute path.
+		d := errDiags[0]
+		return d.AttributePath.NewErrorf("%s - %s", d.Summary, d.Detail)
+	}
+
+	// Joined errors are reported without an attribute path, so name the attribute in each message.
+	var joinedErrs []error
+	for _, d := range errDiags {
+		joinedErrs = append(joinedErrs, fmt.Errorf("%s: %s - %s", attributeNameFromPath(d.AttributePath), d.Summary, d.Detail))
+	}
+
+	return errors.Join(joinedErrs...)
+}
@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestPolicyDocumentAttributes(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"assume_role_policy": {
+			Type:             schema.TypeString,
+			Required:         true,
+			DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+		},
+		"computed_policy": {
+			Type:             schema.TypeString,
+			Computed:         true,
+			DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+		},
+		names.AttrDescription: {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"inline_policy": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrName: {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					names.AttrPolicy: {
+						Type:             schema.TypeString,
+						Optional:         true,
+						DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+					},
+				},
+			},
+		},
+	}
+
+	got := policyDocumentAttributes(s)
+	want := []string{"assume_role_policy", "inline_policy.policy"}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestAttributeNameFromPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     cty.Path
+		expected string
+	}{
+		"top-level": {
+			path:     cty.GetAttrPath(names.AttrPolicy),
+			expected: "policy",
+		},
+		"list": {
+			path:     cty.GetAttrPath("details").IndexInt(0).GetAttr(names.AttrPolicy),
+			expected: "details.policy",
+		},
+		"set": {
+			path:     cty.GetAttrPath("inline_policy").Index(cty.ObjectVal(map[string]cty.Value{})).GetAttr(names.AttrPolicy),
+			expected: "inline_policy.policy",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := attributeNameFromPath(testCase.path), testCase.expected; got != want {
+				t.Errorf("attributeNameFromPath() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestPolicyValidationDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	path := cty.GetAttrPath("inline_policy").Index(cty.ObjectVal(map[string]cty.Value{})).GetAttr(names.AttrPolicy)
+	findings := []awstypes.ValidatePolicyFinding{
+		{
+			FindingType:    awstypes.ValidatePolicyFindingTypeSecurityWarning,
+			IssueCode:      aws.String("PASS_ROLE_WITH_STAR_IN_RESOURCE"),
+			FindingDetails: aws.String("Using the iam:PassRole action with wildcards (*) in the resource can be overly permissive."),
+		},
+		{
+			FindingType:    awstypes.ValidatePolicyFindingTypeError,
+			IssueCode:      aws.String("INVALID_ACTION"),
+			FindingDetails: aws.String("The action s3:GetObjekt does not exist."),
+		},
+	}
+
+	testCases := map[string]struct {
+		severity string
+		expected diag.Severity
+	}{
+		"warning": {
+			severity: "warning",
+			expected: diag.Warning,
+		},
+		"error": {
+			severity: "error",
+			expected: diag.Error,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := policyValidationDiagnostics(testCase.severity, path, findings)
+
+			if got, want := len(diags), len(findings); got != want {
+				t.Fatalf("got %d diagnostics, want %d", got, want)
+			}
+			for _, d := range diags {
+				if got, want := d.Severity, testCase.expected; got != want {
+					t.Errorf("diagnostic %q: got severity %v, want %v", d.Summary, got, want)
+				}
+				if !d.AttributePath.Equals(path) {
+					t.Errorf("diagnostic %q: got attribute path %#v, want %#v", d.Summary, d.AttributePath, path)
+				}
+			}
+		})
+	}
+}
+
+func TestReportPlanDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	assumeRolePolicyPath := cty.GetAttrPath("assume_role_policy")
+	detailsPolicyPath := cty.GetAttrPath("details").IndexInt(0).GetAttr(names.AttrPolicy)
+	diags := diag.Diagnostics{
+		{Severity: diag.Warning, Summary: "Policy Validation Security Warning (PASS_ROLE_WITH_STAR_IN_RESOURCE)", Detail: "warning detail", AttributePath: assumeRolePolicyPath},
+		{Severity: diag.Error, Summary: "Policy Validation Error (INVALID_ACTION)", Detail: "error detail", AttributePath: assumeRolePolicyPath},
+		{Severity: diag.Error, Summary: "Policy Validation Error (MISSING_VERSION)", Detail: "error detail", AttributePath: detailsPolicyPath},
+	}
+
+	t.Run("plan", func(t *testing.T) {
+		t.Parallel()
+
+		planDiags := &planDiagnostics{}
+		ctx := context.WithValue(t.Context(), planDiagnosticsKey{}, planDiags)
+
+		if err := reportPlanDiagnostics(ctx, diags); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		got := planDiags.proto()
+		want := []*tfprotov5.Diagnostic{
+			{
+				Severity:  tfprotov5.DiagnosticSeverityWarning,
+				Summary:   "Policy Validation Security Warning (PASS_ROLE_WITH_STAR_IN_RESOURCE)",
+				Detail:    "warning detail",
+				Attribute: tftypes.NewAttributePath().WithAttributeName("assume_role_policy"),
+			},
+			{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "Policy Validation Error (INVALID_ACTION)",
+				Detail:    "error detail",
+				Attribute: tftypes.NewAttributePath().WithAttributeName("assume_role_policy"),
+			},
+			{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "Policy Validation Error (MISSING_VERSION)",
+				Detail:    "error detail",
+				Attribute: tftypes.NewAttributePath().WithAttributeName("details").WithElementKeyInt(0).WithAttributeName(names.AttrPolicy),
+			},
+		}
+
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+		}
+	})
+
+	t.Run("no plan single error", func(t *testing.T) {
+		t.Parallel()
+
+		err := reportPlanDiagnostics(t.Context(), diags[:2])
+
+		var pathErr cty.PathError
+		if !errors.As(err, &pathErr) {
+			t.Fatalf("got error %v, want a path error", err)
+		}
+		if !pathErr.Path.Equals(assumeRolePolicyPath) {
+			t.Errorf("got attribute path %#v, want %#v", pathErr.Path, assumeRolePolicyPath)
+		}
+	})
+
+	t.Run("no plan multiple errors", func(t *testing.T) {
+		t.Parallel()
+
+		err := reportPlanDiagnostics(t.Context(), diags)
+
+		want := "assume_role_policy: Policy Validation Error (INVALID_ACTION) - error detail\n" +
+			"details.policy: Policy Validation Error (MISSING_VERSION) - error detail"
+		if err == nil || err.Error() != want {
+			t.Errorf("got error %v, want %q", err, want)
+		}
+	})
+}
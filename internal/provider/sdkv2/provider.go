@@ -26,9 +26,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/ratelimit"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -69,6 +72,13 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Optional:      true,
 					ConflictsWith: []string{"forbidden_account_ids"},
 				},
+				"api_call_report": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "Path of a local file to which a JSON summary of the AWS API calls made by each provider process is written when the process exits. " +
+						"The process ID is inserted before the file extension, e.g. `api-calls.json` becomes `api-calls.1234.json`. " +
+						"Calls are grouped by resource type and operation, with call, retry and throttle counts and p50/p95 latencies.",
+				},
 				"assume_role":                   assumeRoleSchema(),
 				"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 				"custom_ca_bundle": {
@@ -163,12 +173,22 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 						"being executed. If the API request still fails, an error is\n" +
 						"thrown.",
 				},
+				"naming": namingSchema(),
 				"no_proxy": {
 					Type:     schema.TypeString,
 					Optional: true,
 					Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. " +
 						"Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 				},
+				"policy_validation": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: `The severity with which to report IAM Access Analyzer policy validation findings for policy documents in resources managed by this provider instance. ` +
+						`Policy documents are validated during plan and ERROR and SECURITY_WARNING findings are reported. ` +
+						`Valid values are "error", "warning", and "disabled". ` +
+						`When unset or "disabled", policy documents will not be validated by the provider. ` +
+						`Can also be configured with the ` + interceptors.PolicyValidationEnvVar + ` environment variable.`,
+				},
 				"profile": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -181,6 +201,13 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The region where AWS operations will take place. Examples\n" +
 						"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 				},
+				"resource_concurrency_limits": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeInt},
+					Description: "Maximum number of concurrent create, update and delete operations per resource type, " +
+						"e.g. `aws_route53_record`. Overrides the resource type's default limit; `0` removes the limit.",
+				},
 				"retry_mode": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -208,6 +235,7 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The secret key for API operations. You can retrieve this\n" +
 						"from the 'Security & Credentials' section of the AWS console.",
 				},
+				"service_rate_limits": serviceRateLimitsSchema(),
 				"shared_config_files": {
 					Type:        schema.TypeList,
 					Optional:    true,
@@ -255,11 +283,17 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Type:     schema.TypeString,
 					Optional: true,
 					Description: `The severity with which to enforce organizational tagging policies on resources managed by this provider instance. ` +
-						`At this time this only includes compliance with required tag keys by resource type. ` +
+						`This includes compliance with required tag keys by resource type, tag key capitalization and allowed tag values. ` +
 						`Valid values are "error", "warning", and "disabled". ` +
 						`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 						`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
 				},
+				"tag_policy_file": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: `The path to a local JSON tag policy document to enforce instead of the effective tag policy of the account. ` +
+						`Only used when tag policy compliance is enabled.`,
+				},
 				"token": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -344,6 +378,7 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		APICallReport:                  d.Get("api_call_report").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -492,11 +527,46 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		return nil, diags
 	}
 	config.TagPolicyConfig = tagCfg
+	config.TagPolicyFile = d.Get("tag_policy_file").(string)
+
+	policyValidation, dg := expandPolicyValidation(cty.GetAttrPath("policy_validation"), d.Get("policy_validation").(string))
+	diags = append(diags, dg...)
+	if dg.HasError() {
+		return nil, diags
+	}
+	config.PolicyValidation = policyValidation
 
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("naming"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		namingConfig, dg := expandNamingConfig(cty.GetAttrPath("naming").IndexInt(0), v.([]any)[0].(map[string]any), p.resourceTypeNames(ctx))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.NamingConfig = namingConfig
+	}
+
+	if v, ok := d.GetOk("service_rate_limits"); ok && len(v.([]any)) > 0 {
+		limits, dg := expandServiceRateLimits(cty.GetAttrPath("service_rate_limits"), v.([]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.ServiceRateLimits = limits
+	}
+
+	if v, ok := d.GetOk("resource_concurrency_limits"); ok && len(v.(map[string]any)) > 0 {
+		limits, dg := expandResourceConcurrencyLimits(cty.GetAttrPath("resource_concurrency_limits"), v.(map[string]any), p.resourceTypeNames(ctx))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.ResourceConcurrencyLimits = limits
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]any)) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]any))
 	}
@@ -533,6 +603,22 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 	return c, diags
 }
 
+// resourceTypeNames returns the type names of all SDKv2 and Plugin Framework resources.
+func (p *sdkProvider) resourceTypeNames(ctx context.Context) map[string]struct{} {
+	typeNames := make(map[string]struct{})
+
+	for _, sp := range p.servicePackages {
+		for _, v := range sp.SDKResources(ctx) {
+			typeNames[v.TypeName] = struct{}{}
+		}
+		for _, v := range sp.FrameworkResources(ctx) {
+			typeNames[v.TypeName] = struct{}{}
+		}
+	}
+
+	return typeNames
+}
+
 // initialize is called from `New` to perform any Terraform Plugin SDK v2-style initialization.
 func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServicePackage, error) {
 	log.Printf("Initializing Terraform AWS Provider (SDKv2-style)...")
@@ -728,6 +814,22 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				})
 			}
 
+			if attributeNames := policyDocumentAttributes(r.SchemaMap()); len(attributeNames) > 0 {
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: validatePolicyDocuments(attributeNames),
+				})
+			}
+
+			if hasNameAndNamePrefix(r.SchemaMap()) {
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: validateNamingConvention(),
+				})
+			}
+
 			if len(resource.Identity.Attributes) > 0 {
 				r.Identity = newResourceIdentity(resource.Identity)
 
@@ -763,6 +865,13 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				}
 			}
 
+			// Must be the last Before interceptor.
+			interceptors = append(interceptors, interceptorInvocation{
+				when:        Before | Finally,
+				why:         Create | Update | Delete,
+				interceptor: resourceConcurrencyLimit(resource.ConcurrencyLimit),
+			})
+
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
 				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, getProviderMeta getProviderMetaFunc, meta any) (context.Context, error) {
@@ -1059,6 +1168,234 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]any) (*
 	return &assumeRole, nil
 }
 
+func serviceRateLimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with client-side rate limits for individual AWS services.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"burst": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "The maximum number of requests that may be made at once before `requests_per_second` applies. Defaults to 1.",
+				},
+				"max_concurrency": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "The maximum number of in-flight requests to the service.",
+				},
+				"requests_per_second": {
+					Type:        schema.TypeFloat,
+					Optional:    true,
+					Description: "The sustained rate of requests to the service.",
+				},
+				"service": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The service to rate limit, using the same names as the `endpoints` block, e.g. `iam` or `route53`.",
+				},
+			},
+		},
+	}
+}
+
+func expandServiceRateLimits(path cty.Path, tfList []any) (map[string]ratelimit.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	limits := make(map[string]ratelimit.Config, len(tfList))
+
+	for i, tfMapRaw := range tfList {
+		path := path.IndexInt(i)
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			diags = append(diags, errs.NewAttributeRequiredError(path, "service"))
+			continue
+		}
+
+		service, _ := tfMap["service"].(string)
+		if service == "" {
+			diags = append(diags, errs.NewAttributeRequiredError(path, "service"))
+			continue
+		}
+
+		servicePackageName, err := servicePackageNameForRateLimit(service)
+		if err != nil {
+			diags = append(diags, errs.NewInvalidValueAttributeError(path.GetAttr("service"), err.Error()))
+			continue
+		}
+
+		if _, ok := limits[servicePackageName]; ok {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("service"), "duplicate rate limit for service %q", servicePackageName))
+			continue
+		}
+
+		cfg := ratelimit.Config{}
+		if v, ok := tfMap["burst"].(int); ok {
+			cfg.Burst = v
+		}
+		if v, ok := tfMap["max_concurrency"].(int); ok {
+			cfg.MaxConcurrency = v
+		}
+		if v, ok := tfMap["requests_per_second"].(float64); ok {
+			cfg.RequestsPerSecond = v
+		}
+
+		if err := cfg.Validate(); err != nil {
+			diags = append(diags, errs.NewInvalidValueAttributeCombinationError(path, err.Error()))
+			continue
+		}
+
+		limits[servicePackageName] = cfg
+	}
+
+	return limits, diags
+}
+
+// servicePackageNameForRateLimit returns the provider service package name for a
+// service name or alias, as used in the `endpoints` block.
+func servicePackageNameForRateLimit(service string) (string, error) {
+	if slices.Contains(names.ProviderPackages(), service) {
+		return service, nil
+	}
+
+	return names.ProviderPackageForAlias(service)
+}
+
+func namingSchema() *schema.Schema {
+	policySchema := func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			"character_set": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A regular expression matching a single allowed character of resource names, e.g. `[a-z0-9-]`.",
+			},
+			"max_length": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The maximum length of resource names.",
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The prefix that resource names must begin with, also used instead of the default prefix of generated names. " +
+					"May contain the `{region}`, `{account_id}` and `{env}` tokens.",
+			},
+		}
+	}
+
+	resourceTypeSchema := policySchema()
+	resourceTypeSchema["type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The resource type name, e.g. `aws_sqs_queue`.",
+	}
+
+	namingSchema := policySchema()
+	namingSchema["env"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The value of the `{env}` token in name prefixes.",
+	}
+	namingSchema["resource_type"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with naming policies for individual resource types, overriding the provider-wide naming policy.",
+		Elem: &schema.Resource{
+			Schema: resourceTypeSchema,
+		},
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Description: "Configuration block with a naming convention for the `name` and `name_prefix` arguments of resources " +
+			"and for names generated by the provider.",
+		Elem: &schema.Resource{
+			Schema: namingSchema,
+		},
+	}
+}
+
+func expandNamingConfig(path cty.Path, tfMap map[string]any, resourceTypeNames map[string]struct{}) (*create.NamingConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	expandPolicy := func(tfMap map[string]any) create.NamingPolicy {
+		var policy create.NamingPolicy
+		if v, ok := tfMap["character_set"].(string); ok {
+			policy.CharacterSet = v
+		}
+		if v, ok := tfMap["max_length"].(int); ok {
+			policy.MaxLength = v
+		}
+		if v, ok := tfMap["prefix"].(string); ok {
+			policy.Prefix = v
+		}
+		return policy
+	}
+
+	namingConfig := &create.NamingConfig{
+		Default: expandPolicy(tfMap),
+	}
+	if v, ok := tfMap["env"].(string); ok {
+		namingConfig.Env = v
+	}
+
+	if v, ok := tfMap["resource_type"].([]any); ok && len(v) > 0 {
+		namingConfig.ResourceTypes = make(map[string]create.NamingPolicy, len(v))
+
+		for i, tfMapRaw := range v {
+			path := path.GetAttr("resource_type").IndexInt(i)
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				diags = append(diags, errs.NewAttributeRequiredError(path, "type"))
+				continue
+			}
+
+			typeName, _ := tfMap["type"].(string)
+			if _, ok := resourceTypeNames[typeName]; !ok {
+				diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("type"), "%q is not a resource type supported by this provider", typeName))
+				continue
+			}
+
+			if _, ok := namingConfig.ResourceTypes[typeName]; ok {
+				diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("type"), "duplicate naming policy for resource type %q", typeName))
+				continue
+			}
+
+			namingConfig.ResourceTypes[typeName] = expandPolicy(tfMap)
+		}
+	}
+
+	if err := namingConfig.Validate(); err != nil {
+		diags = append(diags, errs.NewInvalidValueAttributeCombinationError(path, err.Error()))
+	}
+
+	return namingConfig, diags
+}
+
+func expandResourceConcurrencyLimits(path cty.Path, tfMap map[string]any, resourceTypeNames map[string]struct{}) (map[string]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	limits := make(map[string]int, len(tfMap))
+
+	for typeName, v := range tfMap {
+		if _, ok := resourceTypeNames[typeName]; !ok {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.IndexString(typeName), "%q is not a resource type supported by this provider", typeName))
+			continue
+		}
+
+		limit, _ := v.(int)
+		if limit < 0 {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.IndexString(typeName), "concurrency limit must not be negative, got %d", limit))
+			continue
+		}
+
+		limits[typeName] = limit
+	}
+
+	return limits, diags
+}
+
 func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.DefaultConfig {
 	tags := make(map[string]any)
 	for _, ev := range os.Environ() {
@@ -1133,15 +1470,27 @@ func expandTagPolicyConfig(path cty.Path, severity string) (*tftags.TagPolicyCon
 	envSeverity := os.Getenv(tftags.TagPolicyComplianceEnvVar)
 	switch {
 	case severity != "" && severity != "disabled":
-		return &tftags.TagPolicyConfig{Severity: severity}, validateTagPolicySeverity(path, severity)
+		return &tftags.TagPolicyConfig{Severity: severity}, validateSeverity(path, severity)
 	case envSeverity != "" && severity != "disabled":
-		return &tftags.TagPolicyConfig{Severity: envSeverity}, validateTagPolicySeverityEnvVar(envSeverity)
+		return &tftags.TagPolicyConfig{Severity: envSeverity}, validateSeverityEnvVar(tftags.TagPolicyComplianceEnvVar, envSeverity)
 	}
 
 	return nil, nil
 }
 
-func validateTagPolicySeverity(path cty.Path, s string) diag.Diagnostics {
+func expandPolicyValidation(path cty.Path, severity string) (string, diag.Diagnostics) {
+	envSeverity := os.Getenv(interceptors.PolicyValidationEnvVar)
+	switch {
+	case severity != "" && severity != "disabled":
+		return severity, validateSeverity(path, severity)
+	case envSeverity != "" && envSeverity != "disabled" && severity != "disabled":
+		return envSeverity, validateSeverityEnvVar(interceptors.PolicyValidationEnvVar, envSeverity)
+	}
+
+	return "", nil
+}
+
+func validateSeverity(path cty.Path, s string) diag.Diagnostics {
 	var diags diag.Diagnostics
 	switch s {
 	case "error", "warning", "disabled":
@@ -1154,7 +1503,7 @@ const (
 	summaryInvalidEnvironmentVariableValue = "Invalid environment variable value"
 )
 
-func validateTagPolicySeverityEnvVar(s string) diag.Diagnostics {
+func validateSeverityEnvVar(envVar, s string) diag.Diagnostics {
 	var diags diag.Diagnostics
 	switch s {
 	case "error", "warning", "disabled":
@@ -1162,6 +1511,6 @@ func validateTagPolicySeverityEnvVar(s string) diag.Diagnostics {
 	}
 	return append(diags, errs.NewErrorDiagnostic(
 		summaryInvalidEnvironmentVariableValue,
-		fmt.Sprintf(`%s must be one of "error", "warning", or "disabled"`, tftags.TagPolicyComplianceEnvVar),
+		fmt.Sprintf(`%s must be one of "error", "warning", or "disabled"`, envVar),
 	))
 }
@@ -20,7 +20,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/ratelimit"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	tfunique "github.com/hashicorp/terraform-provider-aws/internal/unique"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -752,3 +755,316 @@ func validateResourceSchemas(ctx context.Context, servicePackages iter.Seq2[int,
 
 	return errors.Join(errs...)
 }
+
+func TestExpandServiceRateLimits(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfList    []any
+		expected  map[string]ratelimit.Config
+		expectErr bool
+	}{
+		"package name": {
+			tfList: []any{
+				map[string]any{"service": "iam", "requests_per_second": 2.5, "burst": 5, "max_concurrency": 0},
+			},
+			expected: map[string]ratelimit.Config{
+				names.IAM: {RequestsPerSecond: 2.5, Burst: 5},
+			},
+		},
+		"alias": {
+			tfList: []any{
+				map[string]any{"service": "prometheus", "requests_per_second": 0.0, "burst": 0, "max_concurrency": 4},
+			},
+			expected: map[string]ratelimit.Config{
+				names.AMP: {MaxConcurrency: 4},
+			},
+		},
+		"unknown service": {
+			tfList: []any{
+				map[string]any{"service": "nosuchservice", "requests_per_second": 1.0, "burst": 0, "max_concurrency": 0},
+			},
+			expectErr: true,
+		},
+		"duplicate service": {
+			tfList: []any{
+				map[string]any{"service": "iam", "requests_per_second": 1.0, "burst": 0, "max_concurrency": 0},
+				map[string]any{"service": "iam", "requests_per_second": 2.0, "burst": 0, "max_concurrency": 0},
+			},
+			expectErr: true,
+		},
+		"no limit": {
+			tfList: []any{
+				map[string]any{"service": "iam", "requests_per_second": 0.0, "burst": 0, "max_concurrency": 0},
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := expandServiceRateLimits(cty.GetAttrPath("service_rate_limits"), testcase.tfList)
+
+			if got, want := diags.HasError(), testcase.expectErr; got != want {
+				t.Fatalf("expected error %t, got diags: %v", want, diags)
+			}
+			if testcase.expectErr {
+				return
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandResourceConcurrencyLimits(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfMap     map[string]any
+		expected  map[string]int
+		expectErr bool
+	}{
+		"limits": {
+			tfMap: map[string]any{
+				"aws_route53_record":    2,
+				"aws_lambda_permission": 0,
+			},
+			expected: map[string]int{
+				"aws_route53_record":    2,
+				"aws_lambda_permission": 0,
+			},
+		},
+		"not a resource type": {
+			tfMap: map[string]any{
+				"route53_record": 2,
+			},
+			expectErr: true,
+		},
+		"unsupported resource type": {
+			tfMap: map[string]any{
+				"aws_route53_recrod": 2,
+			},
+			expectErr: true,
+		},
+		"negative": {
+			tfMap: map[string]any{
+				"aws_route53_record": -1,
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resourceTypeNames := map[string]struct{}{
+				"aws_lambda_permission": {},
+				"aws_route53_record":    {},
+			}
+			got, diags := expandResourceConcurrencyLimits(cty.GetAttrPath("resource_concurrency_limits"), testcase.tfMap, resourceTypeNames)
+
+			if got, want := diags.HasError(), testcase.expectErr; got != want {
+				t.Fatalf("expected error %t, got diags: %v", want, diags)
+			}
+			if testcase.expectErr {
+				return
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandNamingConfig(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfMap     map[string]any
+		expected  *create.NamingConfig
+		expectErr bool
+	}{
+		"default policy": {
+			tfMap: map[string]any{
+				"character_set": "[a-z0-9-]",
+				"env":           "prod",
+				"max_length":    64,
+				"prefix":        "{env}-{region}-",
+				"resource_type": []any{},
+			},
+			expected: &create.NamingConfig{
+				Env: "prod",
+				Default: create.NamingPolicy{
+					Prefix:       "{env}-{region}-",
+					MaxLength:    64,
+					CharacterSet: "[a-z0-9-]",
+				},
+			},
+		},
+		"resource type policies": {
+			tfMap: map[string]any{
+				"character_set": "",
+				"env":           "",
+				"max_length":    0,
+				"prefix":        "{account_id}-",
+				"resource_type": []any{
+					map[string]any{"type": "aws_elb", "character_set": "", "max_length": 32, "prefix": ""},
+					map[string]any{"type": "aws_sqs_queue", "character_set": "[a-zA-Z0-9_-]", "max_length": 0, "prefix": "{region}_"},
+				},
+			},
+			expected: &create.NamingConfig{
+				Default: create.NamingPolicy{
+					Prefix: "{account_id}-",
+				},
+				ResourceTypes: map[string]create.NamingPolicy{
+					"aws_elb":       {MaxLength: 32},
+					"aws_sqs_queue": {Prefix: "{region}_", CharacterSet: "[a-zA-Z0-9_-]"},
+				},
+			},
+		},
+		"not a resource type": {
+			tfMap: map[string]any{
+				"resource_type": []any{
+					map[string]any{"type": "sqs_queue", "character_set": "", "max_length": 80, "prefix": ""},
+				},
+			},
+			expectErr: true,
+		},
+		"unsupported resource type": {
+			tfMap: map[string]any{
+				"resource_type": []any{
+					map[string]any{"type": "aws_sqs_queeu", "character_set": "", "max_length": 80, "prefix": ""},
+				},
+			},
+			expectErr: true,
+		},
+		"duplicate resource type": {
+			tfMap: map[string]any{
+				"resource_type": []any{
+					map[string]any{"type": "aws_elb", "character_set": "", "max_length": 32, "prefix": ""},
+					map[string]any{"type": "aws_elb", "character_set": "", "max_length": 30, "prefix": ""},
+				},
+			},
+			expectErr: true,
+		},
+		"env not set": {
+			tfMap: map[string]any{
+				"prefix": "{env}-",
+			},
+			expectErr: true,
+		},
+		"unsupported token": {
+			tfMap: map[string]any{
+				"prefix": "{team}-",
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resourceTypeNames := map[string]struct{}{
+				"aws_elb":       {},
+				"aws_sqs_queue": {},
+			}
+			got, diags := expandNamingConfig(cty.GetAttrPath("naming").IndexInt(0), testcase.tfMap, resourceTypeNames)
+
+			if got, want := diags.HasError(), testcase.expectErr; got != want {
+				t.Fatalf("expected error %t, got diags: %v", want, diags)
+			}
+			if testcase.expectErr {
+				return
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandPolicyValidation(t *testing.T) { //nolint:paralleltest
+	testcases := map[string]struct {
+		severity  string
+		envvars   map[string]string
+		expected  string
+		expectErr bool
+	}{
+		"unset": {
+			expected: "",
+		},
+		"config": {
+			severity: "error",
+			expected: "error",
+		},
+		"config disabled": {
+			severity: "disabled",
+			envvars: map[string]string{
+				interceptors.PolicyValidationEnvVar: "error",
+			},
+			expected: "",
+		},
+		"config invalid": {
+			severity:  "fatal",
+			expectErr: true,
+		},
+		"envvar": {
+			envvars: map[string]string{
+				interceptors.PolicyValidationEnvVar: "warning",
+			},
+			expected: "warning",
+		},
+		"envvar disabled": {
+			envvars: map[string]string{
+				interceptors.PolicyValidationEnvVar: "disabled",
+			},
+			expected: "",
+		},
+		"envvar invalid": {
+			envvars: map[string]string{
+				interceptors.PolicyValidationEnvVar: "fatal",
+			},
+			expectErr: true,
+		},
+		"config and envvar": {
+			severity: "warning",
+			envvars: map[string]string{
+				interceptors.PolicyValidationEnvVar: "error",
+			},
+			expected: "warning",
+		},
+	}
+
+	for name, testcase := range testcases { //nolint:paralleltest
+		t.Run(name, func(t *testing.T) {
+			oldEnv := stashEnv()
+			defer popEnv(oldEnv)
+
+			for k, v := range testcase.envvars {
+				os.Setenv(k, v) //nolint:usetesting // stashEnv & popEnv require os.Setenv
+			}
+
+			got, diags := expandPolicyValidation(cty.GetAttrPath("policy_validation"), testcase.severity)
+
+			if got, want := diags.HasError(), testcase.expectErr; got != want {
+				t.Fatalf("expected error %t, got diags: %v", want, diags)
+			}
+			if testcase.expectErr {
+				return
+			}
+
+			if got != testcase.expected {
+				t.Errorf("expected %q, got %q", testcase.expected, got)
+			}
+		})
+	}
+}
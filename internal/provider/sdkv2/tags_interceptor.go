@@ -5,8 +5,10 @@ package sdkv2
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
+	"strings"
 	"unique"
 
 	"github.com/hashicorp/go-cty/cty"
@@ -342,8 +344,8 @@ func validateRequiredTags() customizeDiffInterceptor {
 		if policy == nil {
 			return nil
 		}
-		reqTags, ok := policy.RequiredTags[typeName]
-		if !ok {
+		reqTags := policy.RequiredTags[typeName]
+		if len(reqTags) == 0 && len(policy.TagRules[typeName]) == 0 {
 			return nil
 		}
 
@@ -364,30 +366,46 @@ func validateRequiredTags() customizeDiffInterceptor {
 
 				cfgTags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))
 				allTags := c.DefaultTagsConfig(ctx).MergeTags(cfgTags)
-				if allTags.ContainsAllKeys(reqTags) {
-					return nil
+
+				var errs []error
+
+				if !allTags.ContainsAllKeys(reqTags) {
+					missing := reqTags.Removed(allTags).Keys()
+					slices.Sort(missing)
+					summary := "Missing Required Tags"
+					detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
+
+					errs = append(errs, tagPolicyViolation(ctx, policy.Severity, summary, detail))
 				}
 
-				missing := reqTags.Removed(allTags).Keys()
-				slices.Sort(missing)
-				summary := "Missing Required Tags"
-				detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
-
-				// CustomizeDiff does not support diagnostics (only an error return)
-				switch policy.Severity {
-				case "warning":
-					// Warning diagnostics are only logged
-					tflog.Warn(ctx, "Required Tags Validation", map[string]any{
-						"summary": summary,
-						"detail":  detail,
-					})
-				default:
-					// Error diagnostics merge summary and detail into a single message
-					return fmt.Errorf("%s - %s", summary, detail)
+				if noncompliant := policy.NoncompliantTags(typeName, allTags); len(noncompliant) > 0 {
+					summary := "Noncompliant Tags"
+					detail := fmt.Sprintf("An organizational tag policy does not allow the following tags for %s: %s", typeName, strings.Join(noncompliant, "; "))
+
+					errs = append(errs, tagPolicyViolation(ctx, policy.Severity, summary, detail))
 				}
+
+				return errors.Join(errs...)
 			}
 		}
 
 		return nil
 	})
 }
+
+// tagPolicyViolation reports a tag policy violation with the specified severity.
+// CustomizeDiff does not support diagnostics (only an error return), so
+// warning diagnostics are only logged and nil is returned.
+func tagPolicyViolation(ctx context.Context, severity, summary, detail string) error {
+	switch severity {
+	case "warning":
+		tflog.Warn(ctx, "Tag Policy Validation", map[string]any{
+			"summary": summary,
+			"detail":  detail,
+		})
+		return nil
+	default:
+		// Error diagnostics merge summary and detail into a single message
+		return fmt.Errorf("%s - %s", summary, detail)
+	}
+}
@@ -11,7 +11,10 @@ var (
 	FindNamedQueryByID                = findNamedQueryByID
 	FindPreparedStatementByTwoPartKey = findPreparedStatementByTwoPartKey
 	FindWorkGroupByName               = findWorkGroupByName
+	FlattenQueryResultRows            = flattenQueryResultRows
 	QueryExecutionResult              = queryExecutionResult
+	QueryExecutionStateChangeReason   = queryExecutionStateChangeReason
+	ReadOnlyQueryRegexp               = readOnlyQueryRegexp
 
 	ResourceCapacityReservation = newCapacityReservationResource
 	ResourceDataCatalog         = resourceDataCatalog
@@ -0,0 +1,236 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	queryResultsDefaultMaxRows = 1000
+	queryResultsTimeout        = 10 * time.Minute
+)
+
+// readOnlyQueryRegexp matches query statements whose first keyword, after any comments and opening parentheses, is SELECT or WITH.
+var readOnlyQueryRegexp = regexache.MustCompile(`(?is)^(?:\s|--[^\n]*(?:\n|$)|/\*.*?\*/|\()*(?:select|with)\b`)
+
+// @EphemeralResource("aws_athena_query_results", name="Query Results")
+func newQueryResultsEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &queryResultsEphemeralResource{}, nil
+}
+
+type queryResultsEphemeralResource struct {
+	framework.EphemeralResourceWithModel[queryResultsEphemeralResourceModel]
+}
+
+func (e *queryResultsEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"catalog": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the data catalog used in the query execution.",
+			},
+			"column_names": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Computed:    true,
+				Description: "The names of the columns in the query results, in order.",
+			},
+			names.AttrDatabase: schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the database used in the query execution.",
+			},
+			"execution_parameters": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Optional:    true,
+				Description: "Values for the parameters in a parameterized query, in the order in which the parameters occur.",
+			},
+			"max_rows": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 10000),
+				},
+				Description: fmt.Sprintf("The maximum number of result rows to return. The query fails if it returns more rows. Defaults to `%d`.", queryResultsDefaultMaxRows),
+			},
+			"output_location": schema.StringAttribute{
+				Optional:    true,
+				Description: "The S3 location in which to store query results. Required unless the workgroup specifies a query result location or uses managed query results.",
+			},
+			"query_execution_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the query execution.",
+			},
+			"query_string": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(readOnlyQueryRegexp, "must be a SELECT query"),
+				},
+				Description: "The SQL SELECT query statement to run. The query is run each time the ephemeral resource is opened, including during every plan.",
+			},
+			"rows": schema.ListAttribute{
+				ElementType: types.MapType{ElemType: types.StringType},
+				Computed:    true,
+				Description: "The result rows, as maps of column name to value. Null values are represented as `null`.",
+			},
+			"workgroup": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the workgroup in which the query runs. Defaults to `primary`.",
+			},
+		},
+	}
+}
+
+func (e *queryResultsEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data queryResultsEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().AthenaClient(ctx)
+
+	var input athena.StartQueryExecutionInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.QueryExecutionContext = expandQueryExecutionContext(ctx, data.Catalog, data.Database)
+	input.ResultConfiguration = expandQueryResultConfiguration(ctx, data.OutputLocation)
+
+	output, err := conn.StartQueryExecution(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	queryExecutionID := aws.ToString(output.QueryExecutionId)
+
+	queryExecution, err := waitQueryExecutionSucceeded(ctx, conn, queryExecutionID, queryResultsTimeout, nil)
+	if actionwait.IsFailureState(err) {
+		err = fmt.Errorf("%w: %s", err, queryExecutionStateChangeReason(queryExecution))
+	}
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, queryExecutionID)
+		return
+	}
+
+	maxRows := queryResultsDefaultMaxRows
+	if !data.MaxRows.IsNull() {
+		maxRows = int(data.MaxRows.ValueInt32())
+	}
+
+	columnNames, rows, err := findQueryResultRows(ctx, conn, queryExecutionID, queryExecution.StatementType, maxRows)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, queryExecutionID)
+		return
+	}
+
+	data.ColumnNames = fwflex.FlattenFrameworkStringValueListOfString(ctx, columnNames)
+	data.QueryExecutionID = types.StringValue(queryExecutionID)
+	rowsValue, diags := types.ListValueFrom(ctx, types.MapType{ElemType: types.StringType}, rows)
+	smerr.AddEnrich(ctx, &response.Diagnostics, diags)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.Rows = rowsValue
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+// findQueryResultRows returns the column names and rows of a query execution's results.
+// An error is returned if the results contain more than maxRows rows.
+func findQueryResultRows(ctx context.Context, conn *athena.Client, queryExecutionID string, statementType awstypes.StatementType, maxRows int) ([]string, []map[string]*string, error) {
+	input := athena.GetQueryResultsInput{
+		QueryExecutionId: aws.String(queryExecutionID),
+	}
+
+	var columnNames []string
+	var rows []map[string]*string
+
+	pages := athena.NewGetQueryResultsPaginator(conn, &input)
+	for first := true; pages.HasMorePages(); first = false {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if page.ResultSet == nil {
+			continue
+		}
+
+		resultRows := page.ResultSet.Rows
+		if first {
+			if v := page.ResultSet.ResultSetMetadata; v != nil {
+				for _, column := range v.ColumnInfo {
+					columnNames = append(columnNames, aws.ToString(column.Name))
+				}
+			}
+
+			// The first row of the results of a SELECT query contains the column names.
+			if statementType == awstypes.StatementTypeDml && len(resultRows) > 0 {
+				resultRows = resultRows[1:]
+			}
+		}
+
+		rows = append(rows, flattenQueryResultRows(columnNames, resultRows)...)
+
+		if len(rows) > maxRows {
+			return nil, nil, fmt.Errorf("query returned more than %d rows", maxRows)
+		}
+	}
+
+	return columnNames, rows, nil
+}
+
+// flattenQueryResultRows converts query result rows to maps of column name to value.
+func flattenQueryResultRows(columnNames []string, apiObjects []awstypes.Row) []map[string]*string {
+	rows := make([]map[string]*string, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		row := make(map[string]*string, len(columnNames))
+		for i, columnName := range columnNames {
+			if i < len(apiObject.Data) {
+				row[columnName] = apiObject.Data[i].VarCharValue
+			} else {
+				row[columnName] = nil
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+type queryResultsEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Catalog             types.String         `tfsdk:"catalog" autoflex:"-"`
+	ColumnNames         fwtypes.ListOfString `tfsdk:"column_names" autoflex:"-"`
+	Database            types.String         `tfsdk:"database" autoflex:"-"`
+	ExecutionParameters fwtypes.ListOfString `tfsdk:"execution_parameters"`
+	MaxRows             types.Int32          `tfsdk:"max_rows" autoflex:"-"`
+	OutputLocation      types.String         `tfsdk:"output_location" autoflex:"-"`
+	QueryExecutionID    types.String         `tfsdk:"query_execution_id" autoflex:"-"`
+	QueryString         types.String         `tfsdk:"query_string"`
+	Rows                types.List           `tfsdk:"rows" autoflex:"-"`
+	WorkGroup           types.String         `tfsdk:"workgroup"`
+}
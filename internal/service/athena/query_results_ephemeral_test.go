@@ -0,0 +1,188 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package athena_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfathena "github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestFlattenQueryResultRows(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		columnNames []string
+		rows        []awstypes.Row
+		want        []map[string]*string
+	}{
+		"no rows": {
+			columnNames: []string{"id"},
+			want:        []map[string]*string{},
+		},
+		"rows": {
+			columnNames: []string{"id", "name"},
+			rows: []awstypes.Row{
+				{Data: []awstypes.Datum{{VarCharValue: aws.String("1")}, {VarCharValue: aws.String("one")}}},
+				{Data: []awstypes.Datum{{VarCharValue: aws.String("2")}, {}}},
+			},
+			want: []map[string]*string{
+				{"id": aws.String("1"), "name": aws.String("one")},
+				{"id": aws.String("2"), "name": nil},
+			},
+		},
+		"short row": {
+			columnNames: []string{"id", "name"},
+			rows: []awstypes.Row{
+				{Data: []awstypes.Datum{{VarCharValue: aws.String("1")}}},
+			},
+			want: []map[string]*string{
+				{"id": aws.String("1"), "name": nil},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfathena.FlattenQueryResultRows(testCase.columnNames, testCase.rows)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestReadOnlyQueryRegexp(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"SELECT 1":                                   true,
+		"  select * from accounts":                   true,
+		"(SELECT 1) UNION (SELECT 2)":                true,
+		"WITH a AS (SELECT 1) SELECT * FROM a":       true,
+		"-- comment\nSELECT 1":                       true,
+		"/* multi\nline */ SELECT 1":                 true,
+		"INSERT INTO accounts SELECT * FROM staging": false,
+		"DROP TABLE accounts":                        false,
+		"CREATE TABLE a AS SELECT 1":                 false,
+		"-- SELECT\nDELETE FROM accounts":            false,
+		"SELECTED":                                   false,
+	}
+
+	for query, want := range testCases {
+		t.Run(query, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfathena.ReadOnlyQueryRegexp.MatchString(query); got != want {
+				t.Errorf("MatchString(%q) = %t, want %t", query, got, want)
+			}
+		})
+	}
+}
+
+func TestAccAthenaQueryResultsEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dbName := acctest.RandString(t, 8)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.AthenaServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             testAccCheckDatabaseDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryResultsEphemeralResourceConfig_basic(rName, dbName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("column_names"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("id"),
+						knownvalue.StringExact("name"),
+					})),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("query_execution_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("rows"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"id":   knownvalue.StringExact("1"),
+							"name": knownvalue.StringExact("one"),
+						}),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"id":   knownvalue.StringExact("2"),
+							"name": knownvalue.Null(),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccAthenaQueryResultsEphemeral_maxRows(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dbName := acctest.RandString(t, 8)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.AthenaServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             testAccCheckDatabaseDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQueryResultsEphemeralResourceConfig_maxRows(rName, dbName, 1),
+				ExpectError: regexache.MustCompile(`query returned more than 1 rows`),
+			},
+		},
+	})
+}
+
+func testAccQueryResultsEphemeralResourceConfig_basic(rName, dbName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_athena_query_results.test"),
+		testAccQueryExecutionConfig_base(rName, dbName),
+		`
+ephemeral "aws_athena_query_results" "test" {
+  query_string = "SELECT * FROM (VALUES (1, 'one'), (2, NULL)) AS t (id, name) ORDER BY id"
+  workgroup    = aws_athena_workgroup.test.name
+  database     = aws_athena_database.test.name
+}
+`)
+}
+
+func testAccQueryResultsEphemeralResourceConfig_maxRows(rName, dbName string, maxRows int) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_athena_query_results.test"),
+		testAccQueryExecutionConfig_base(rName, dbName),
+		fmt.Sprintf(`
+ephemeral "aws_athena_query_results" "test" {
+  query_string = "SELECT * FROM (VALUES (1, 'one'), (2, 'two')) AS t (id, name)"
+  workgroup    = aws_athena_workgroup.test.name
+  database     = aws_athena_database.test.name
+  max_rows     = %[1]d
+}
+`, maxRows))
+}
@@ -20,6 +20,28 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
+	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newStartQueryExecutionAction,
+			TypeName: "aws_athena_start_query_execution",
+			Name:     "Start Query Execution",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+	}
+}
+
+func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
+	return []*inttypes.ServicePackageEphemeralResource{
+		{
+			Factory:  newQueryResultsEphemeralResource,
+			TypeName: "aws_athena_query_results",
+			Name:     "Query Results",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{}
 }
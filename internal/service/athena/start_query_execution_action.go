@@ -0,0 +1,304 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// queryExecutionPollInterval defines polling cadence while waiting for a query execution to complete.
+	queryExecutionPollInterval = 5 * time.Second
+)
+
+// @Action(aws_athena_start_query_execution, name="Start Query Execution")
+func newStartQueryExecutionAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startQueryExecutionAction{}, nil
+}
+
+var (
+	_ action.Action = (*startQueryExecutionAction)(nil)
+)
+
+type startQueryExecutionAction struct {
+	framework.ActionWithModel[startQueryExecutionActionModel]
+}
+
+type startQueryExecutionActionModel struct {
+	framework.WithRegionModel
+	Catalog             types.String         `tfsdk:"catalog" autoflex:"-"`
+	Database            types.String         `tfsdk:"database" autoflex:"-"`
+	ExecutionParameters fwtypes.ListOfString `tfsdk:"execution_parameters"`
+	OutputLocation      types.String         `tfsdk:"output_location" autoflex:"-"`
+	QueryString         types.String         `tfsdk:"query_string"`
+	Timeout             types.Int64          `tfsdk:"timeout" autoflex:"-"`
+	WorkGroup           types.String         `tfsdk:"workgroup"`
+}
+
+func (a *startQueryExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an Athena query and waits for it to complete. The action fails if the query does not succeed.",
+		Attributes: map[string]schema.Attribute{
+			"catalog": schema.StringAttribute{
+				Description: "Name of the data catalog used in the query execution",
+				Optional:    true,
+			},
+			names.AttrDatabase: schema.StringAttribute{
+				Description: "Name of the database used in the query execution",
+				Optional:    true,
+			},
+			"execution_parameters": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Description: "Values for the parameters in a parameterized query, in the order in which the parameters occur",
+				Optional:    true,
+			},
+			"output_location": schema.StringAttribute{
+				Description: "S3 location in which to store query results, e.g. s3://bucket/prefix/. Required unless the workgroup specifies a query result location or uses managed query results.",
+				Optional:    true,
+			},
+			"query_string": schema.StringAttribute{
+				Description: "SQL query statement to run, e.g. MSCK REPAIR TABLE or CREATE VIEW DDL",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the query to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+			"workgroup": schema.StringAttribute{
+				Description: "Name of the workgroup in which the query runs (default: primary)",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *startQueryExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startQueryExecutionActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().AthenaClient(ctx)
+
+	timeout := fwactions.TimeoutOr(config.Timeout, 30*time.Minute)
+
+	tflog.Info(ctx, "Starting Athena start query execution action", map[string]any{
+		"workgroup":       config.WorkGroup.ValueString(),
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting Athena query execution...")
+
+	var input athena.StartQueryExecutionInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.QueryExecutionContext = expandQueryExecutionContext(ctx, config.Catalog, config.Database)
+	input.ResultConfiguration = expandQueryResultConfiguration(ctx, config.OutputLocation)
+
+	output, err := conn.StartQueryExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Athena Query Execution",
+			fmt.Sprintf("Could not start Athena query execution: %s", err),
+		)
+		return
+	}
+
+	queryExecutionID := aws.ToString(output.QueryExecutionId)
+	cb(ctx, "Athena query execution %s started, waiting for it to complete...", queryExecutionID)
+
+	queryExecution, err := waitQueryExecutionSucceeded(ctx, conn, queryExecutionID, timeout, func(status actionwait.Status) {
+		cb(ctx, "Athena query execution %s is currently in state '%s'...", queryExecutionID, status)
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Athena Query Execution",
+				fmt.Sprintf("Athena query execution %s did not complete within %s and has been stopped.", queryExecutionID, timeout),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Athena Query Execution Failed",
+				fmt.Sprintf("Athena query execution %s finished with status %s: %s", queryExecutionID, failureErr.Status, queryExecutionStateChangeReason(queryExecution)),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Athena Query Execution State",
+				fmt.Sprintf("Athena query execution %s entered unexpected state: %s", queryExecutionID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Athena Query Execution",
+				fmt.Sprintf("Error while waiting for Athena query execution %s to complete: %s", queryExecutionID, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Athena query execution %s completed successfully (%s)", queryExecutionID, queryExecutionStatisticsSummary(queryExecution))
+
+	tflog.Info(ctx, "Athena start query execution action completed successfully", map[string]any{
+		"query_execution_id": queryExecutionID,
+	})
+}
+
+func expandQueryExecutionContext(ctx context.Context, catalog, database types.String) *awstypes.QueryExecutionContext {
+	if catalog.ValueString() == "" && database.ValueString() == "" {
+		return nil
+	}
+
+	return &awstypes.QueryExecutionContext{
+		Catalog:  fwflex.StringFromFramework(ctx, catalog),
+		Database: fwflex.StringFromFramework(ctx, database),
+	}
+}
+
+func expandQueryResultConfiguration(ctx context.Context, outputLocation types.String) *awstypes.ResultConfiguration {
+	if outputLocation.ValueString() == "" {
+		return nil
+	}
+
+	return &awstypes.ResultConfiguration{
+		OutputLocation: fwflex.StringFromFramework(ctx, outputLocation),
+	}
+}
+
+// queryExecutionStateChangeReason returns the reason that a query execution entered its current state.
+func queryExecutionStateChangeReason(queryExecution *awstypes.QueryExecution) string {
+	if queryExecution == nil || queryExecution.Status == nil {
+		return "unknown reason"
+	}
+
+	if v := aws.ToString(queryExecution.Status.StateChangeReason); v != "" {
+		return v
+	}
+
+	if v := queryExecution.Status.AthenaError; v != nil && aws.ToString(v.ErrorMessage) != "" {
+		return aws.ToString(v.ErrorMessage)
+	}
+
+	return "unknown reason"
+}
+
+// queryExecutionStatisticsSummary returns the amount of data scanned and the engine execution time of a query execution,
+// e.g. "1024 bytes scanned in 1.5s".
+func queryExecutionStatisticsSummary(queryExecution *awstypes.QueryExecution) string {
+	if queryExecution == nil || queryExecution.Statistics == nil {
+		return "no statistics"
+	}
+
+	statistics := queryExecution.Statistics
+
+	return fmt.Sprintf("%d bytes scanned in %s", aws.ToInt64(statistics.DataScannedInBytes), time.Duration(aws.ToInt64(statistics.EngineExecutionTimeInMillis))*time.Millisecond)
+}
+
+// waitQueryExecutionSucceeded waits for a query execution to succeed, calling progress, if not nil, with its state every 30 seconds.
+// The query execution is stopped if the wait times out or ctx is cancelled, so that the query isn't left running.
+func waitQueryExecutionSucceeded(ctx context.Context, conn *athena.Client, id string, timeout time.Duration, progress func(actionwait.Status)) (*awstypes.QueryExecution, error) {
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.QueryExecution], error) {
+		queryExecution, err := findQueryExecutionByID(ctx, conn, id)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.QueryExecution]{}, fmt.Errorf("getting query execution: %w", err)
+		}
+
+		return actionwait.FetchResult[*awstypes.QueryExecution]{Status: actionwait.Status(queryExecution.Status.State), Value: queryExecution}, nil
+	}, actionwait.Options[*awstypes.QueryExecution]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(queryExecutionPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.QueryExecutionStateSucceeded)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.QueryExecutionStateQueued),
+			actionwait.Status(awstypes.QueryExecutionStateRunning),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.QueryExecutionStateCancelled),
+			actionwait.Status(awstypes.QueryExecutionStateFailed),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if progress != nil {
+				progress(fr.Status)
+			}
+		},
+	})
+
+	if actionwait.IsTimeout(err) || ctx.Err() != nil {
+		stopQueryExecution(context.WithoutCancel(ctx), conn, id)
+	}
+
+	return fr.Value, err
+}
+
+// stopQueryExecution requests that a query execution be cancelled.
+// Errors are logged rather than returned, as the caller is already reporting why the query had to be stopped.
+func stopQueryExecution(ctx context.Context, conn *athena.Client, id string) {
+	ctx, cancel := context.WithTimeout(ctx, 1*time.Minute)
+	defer cancel()
+
+	input := athena.StopQueryExecutionInput{
+		QueryExecutionId: aws.String(id),
+	}
+	if _, err := conn.StopQueryExecution(ctx, &input); err != nil {
+		tflog.Warn(ctx, "stopping Athena query execution", map[string]any{
+			"query_execution_id": id,
+			"error":              err.Error(),
+		})
+	}
+}
+
+func findQueryExecutionByID(ctx context.Context, conn *athena.Client, id string) (*awstypes.QueryExecution, error) {
+	input := athena.GetQueryExecutionInput{
+		QueryExecutionId: aws.String(id),
+	}
+
+	output, err := conn.GetQueryExecution(ctx, &input)
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "was not found") {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.QueryExecution == nil || output.QueryExecution.Status == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output.QueryExecution, nil
+}
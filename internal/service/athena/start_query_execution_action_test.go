@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package athena_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfathena "github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestQueryExecutionStateChangeReason(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input *awstypes.QueryExecution
+		want  string
+	}{
+		"nil": {
+			want: "unknown reason",
+		},
+		"state change reason": {
+			input: &awstypes.QueryExecution{
+				Status: &awstypes.QueryExecutionStatus{
+					AthenaError: &awstypes.AthenaError{
+						ErrorMessage: aws.String("error message"),
+					},
+					StateChangeReason: aws.String("state change reason"),
+				},
+			},
+			want: "state change reason",
+		},
+		"athena error": {
+			input: &awstypes.QueryExecution{
+				Status: &awstypes.QueryExecutionStatus{
+					AthenaError: &awstypes.AthenaError{
+						ErrorMessage: aws.String("error message"),
+					},
+				},
+			},
+			want: "error message",
+		},
+		"no reason": {
+			input: &awstypes.QueryExecution{
+				Status: &awstypes.QueryExecutionStatus{},
+			},
+			want: "unknown reason",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfathena.QueryExecutionStateChangeReason(testCase.input); got != testCase.want {
+				t.Errorf("QueryExecutionStateChangeReason() = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestAccAthenaStartQueryExecutionAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dbName := acctest.RandString(t, 8)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDatabaseDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartQueryExecutionActionConfig_basic(rName, dbName, "CREATE OR REPLACE VIEW test AS SELECT 1 AS id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStartQueryExecutionActionTableExists(ctx, t, dbName, "test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAthenaStartQueryExecutionAction_queryFailed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dbName := acctest.RandString(t, 8)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDatabaseDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartQueryExecutionActionConfig_basic(rName, dbName, "SELECT * FROM does_not_exist"),
+				ExpectError: regexache.MustCompile(`(?s)Athena Query Execution Failed.*does_not_exist`),
+			},
+		},
+	})
+}
+
+func testAccCheckStartQueryExecutionActionTableExists(ctx context.Context, t *testing.T, dbName, tableName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).AthenaClient(ctx)
+
+		input := athena.GetTableMetadataInput{
+			CatalogName:  aws.String("AwsDataCatalog"),
+			DatabaseName: aws.String(dbName),
+			TableName:    aws.String(tableName),
+		}
+
+		_, err := conn.GetTableMetadata(ctx, &input)
+
+		if err != nil {
+			return fmt.Errorf("Athena Table (%s.%s): %w", dbName, tableName, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccQueryExecutionConfig_base(rName, dbName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_athena_workgroup" "test" {
+  name          = %[1]q
+  force_destroy = true
+
+  configuration {
+    result_configuration {
+      output_location = "s3://${aws_s3_bucket.test.bucket}/output/"
+    }
+  }
+}
+
+resource "aws_athena_database" "test" {
+  name          = %[2]q
+  bucket        = aws_s3_bucket.test.bucket
+  force_destroy = true
+}
+`, rName, dbName)
+}
+
+func testAccStartQueryExecutionActionConfig_basic(rName, dbName, queryString string) string {
+	return acctest.ConfigCompose(testAccQueryExecutionConfig_base(rName, dbName), fmt.Sprintf(`
+action "aws_athena_start_query_execution" "test" {
+  config {
+    query_string = %[1]q
+    workgroup    = aws_athena_workgroup.test.name
+    database     = aws_athena_database.test.name
+  }
+}
+
+resource "terraform_data" "test" {
+  input = aws_athena_database.test.name
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.aws_athena_start_query_execution.test]
+    }
+  }
+}
+`, queryString))
+}
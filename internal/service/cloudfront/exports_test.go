@@ -49,7 +49,12 @@ var (
 	FindTrustStoreByID                         = findTrustStoreByID
 	FindVPCOriginByID                          = findVPCOriginByID
 
-	WaitDistributionDeployed = waitDistributionDeployed
+	NewSigningPolicyStatement = newSigningPolicyStatement
+	ParseSigningPrivateKey    = parseSigningPrivateKey
+	SignedCookies             = signedCookies
+	SigningParameters         = signingParameters
+	SignURL                   = signURL
+	WaitDistributionDeployed  = waitDistributionDeployed
 
 	DefaultConnectionAttempts = defaultConnectionAttempts
 	DefaultConnectionTimeout  = defaultConnectionTimeout
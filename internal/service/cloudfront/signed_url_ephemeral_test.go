@@ -0,0 +1,298 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1" // nosemgrep: go/sast/internal/crypto/sha1 -- CloudFront signed URLs and cookies use RSA-SHA1 signatures, must match AWS behavior
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"maps"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSignURL(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expiration := time.Unix(1357034400, 0)
+	dateGreaterThan := time.Unix(1357030800, 0)
+
+	testCases := map[string]struct {
+		rawURL          string
+		resource        string
+		dateGreaterThan *time.Time
+		ipAddress       string
+		canned          bool
+		wantPolicy      string
+		wantURLPrefix   string
+		wantCookieNames []string
+	}{
+		"canned": {
+			rawURL:          "https://d111111abcdef8.cloudfront.net/image.jpg",
+			resource:        "https://d111111abcdef8.cloudfront.net/image.jpg",
+			canned:          true,
+			wantPolicy:      `{"Statement":[{"Resource":"https://d111111abcdef8.cloudfront.net/image.jpg","Condition":{"DateLessThan":{"AWS:EpochTime":1357034400}}}]}`,
+			wantURLPrefix:   "https://d111111abcdef8.cloudfront.net/image.jpg?Expires=1357034400&Signature=",
+			wantCookieNames: []string{"CloudFront-Expires", "CloudFront-Key-Pair-Id", "CloudFront-Signature"},
+		},
+		"canned with query string": {
+			rawURL:          "https://d111111abcdef8.cloudfront.net/image.jpg?size=large&color=red",
+			resource:        "https://d111111abcdef8.cloudfront.net/image.jpg?size=large&color=red",
+			canned:          true,
+			wantPolicy:      `{"Statement":[{"Resource":"https://d111111abcdef8.cloudfront.net/image.jpg?size=large&color=red","Condition":{"DateLessThan":{"AWS:EpochTime":1357034400}}}]}`,
+			wantURLPrefix:   "https://d111111abcdef8.cloudfront.net/image.jpg?size=large&color=red&Expires=1357034400&Signature=",
+			wantCookieNames: []string{"CloudFront-Expires", "CloudFront-Key-Pair-Id", "CloudFront-Signature"},
+		},
+		"custom": {
+			rawURL:          "https://d111111abcdef8.cloudfront.net/images/image.jpg",
+			resource:        "https://d111111abcdef8.cloudfront.net/images/*",
+			dateGreaterThan: &dateGreaterThan,
+			ipAddress:       "192.0.2.0/24",
+			wantPolicy:      `{"Statement":[{"Resource":"https://d111111abcdef8.cloudfront.net/images/*","Condition":{"DateLessThan":{"AWS:EpochTime":1357034400},"IpAddress":{"AWS:SourceIp":"192.0.2.0/24"},"DateGreaterThan":{"AWS:EpochTime":1357030800}}}]}`,
+			wantURLPrefix:   "https://d111111abcdef8.cloudfront.net/images/image.jpg?Policy=",
+			wantCookieNames: []string{"CloudFront-Key-Pair-Id", "CloudFront-Policy", "CloudFront-Signature"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			statement := tfcloudfront.NewSigningPolicyStatement(testCase.resource, expiration, testCase.dateGreaterThan, testCase.ipAddress)
+			params, err := tfcloudfront.SigningParameters(statement, testCase.canned, "K2JCJMDEHXQW5F", privateKey)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := tfcloudfront.SignURL(testCase.rawURL, params)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !strings.HasPrefix(got, testCase.wantURLPrefix) {
+				t.Errorf("got URL %q, want prefix %q", got, testCase.wantURLPrefix)
+			}
+			if !strings.HasSuffix(got, "&Key-Pair-Id=K2JCJMDEHXQW5F") {
+				t.Errorf("got URL %q, want Key-Pair-Id suffix", got)
+			}
+			if _, err := url.Parse(got); err != nil {
+				t.Errorf("signed URL does not parse: %s", err)
+			}
+
+			cookies := tfcloudfront.SignedCookies(params)
+			if got, want := slices.Sorted(maps.Keys(cookies)), testCase.wantCookieNames; !slices.Equal(got, want) {
+				t.Errorf("got cookies %v, want %v", got, want)
+			}
+			if got, want := cookies["CloudFront-Key-Pair-Id"], "K2JCJMDEHXQW5F"; got != want {
+				t.Errorf("got CloudFront-Key-Pair-Id %q, want %q", got, want)
+			}
+
+			if v, ok := cookies["CloudFront-Policy"]; ok {
+				if got := string(signingBase64Decode(t, v)); got != testCase.wantPolicy {
+					t.Errorf("got policy %s, want %s", got, testCase.wantPolicy)
+				}
+			}
+
+			hash := sha1.Sum([]byte(testCase.wantPolicy)) // nosemgrep: go.lang.security.audit.crypto.use_of_weak_crypto.use-of-sha1 -- CloudFront signed URLs and cookies use RSA-SHA1 signatures, must match AWS behavior
+			signature := signingBase64Decode(t, cookies["CloudFront-Signature"])
+			if err := rsa.VerifyPKCS1v15(&privateKey.PublicKey, crypto.SHA1, hash[:], signature); err != nil {
+				t.Errorf("signature does not verify against policy %s: %s", testCase.wantPolicy, err)
+			}
+		})
+	}
+}
+
+func TestParseSigningPrivateKey(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecPKCS8, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		key         string
+		expectError bool
+	}{
+		"PKCS1": {
+			key: string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)})),
+		},
+		"PKCS8": {
+			key: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8})),
+		},
+		"not PEM": {
+			key:         "not a key",
+			expectError: true,
+		},
+		"not RSA": {
+			key:         string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: ecPKCS8})),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfcloudfront.ParseSigningPrivateKey(testCase.key)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !got.Equal(rsaKey) {
+				t.Error("parsed key does not match")
+			}
+		})
+	}
+}
+
+func TestAccCloudFrontSignedURLEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+	privateKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	rawURL := "https://d111111abcdef8.cloudfront.net/image.jpg"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignedURLEphemeralResourceConfig_basic(rawURL, privateKey),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expiration"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_url"), knownvalue.StringRegexp(regexache.MustCompile(`^`+regexp.QuoteMeta(rawURL)+`\?Expires=\d+&Signature=[0-9A-Za-z~_-]+&Key-Pair-Id=K2JCJMDEHXQW5F$`))),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_cookies"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"CloudFront-Expires":     knownvalue.StringRegexp(regexache.MustCompile(`^\d+$`)),
+						"CloudFront-Key-Pair-Id": knownvalue.StringExact("K2JCJMDEHXQW5F"),
+						"CloudFront-Signature":   knownvalue.StringRegexp(regexache.MustCompile(`^[0-9A-Za-z~_-]+$`)),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontSignedURLEphemeral_customPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+	privateKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	rawURL := "https://d111111abcdef8.cloudfront.net/images/image.jpg"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignedURLEphemeralResourceConfig_customPolicy(rawURL, privateKey),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_url"), knownvalue.StringRegexp(regexache.MustCompile(`^`+regexp.QuoteMeta(rawURL)+`\?Policy=[0-9A-Za-z~_-]+&Signature=[0-9A-Za-z~_-]+&Key-Pair-Id=K2JCJMDEHXQW5F$`))),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_cookies"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"CloudFront-Key-Pair-Id": knownvalue.StringExact("K2JCJMDEHXQW5F"),
+						"CloudFront-Policy":      knownvalue.StringRegexp(regexache.MustCompile(`^[0-9A-Za-z~_-]+$`)),
+						"CloudFront-Signature":   knownvalue.StringRegexp(regexache.MustCompile(`^[0-9A-Za-z~_-]+$`)),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func signingBase64Decode(t *testing.T, s string) []byte {
+	t.Helper()
+
+	b, err := base64.StdEncoding.DecodeString(strings.NewReplacer("-", "+", "_", "=", "~", "/").Replace(s))
+	if err != nil {
+		t.Fatalf("decoding %q: %s", s, err)
+	}
+
+	return b
+}
+
+func testAccSignedURLEphemeralResourceConfig_basic(rawURL, privateKey string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cloudfront_signed_url.test"),
+		fmt.Sprintf(`
+ephemeral "aws_cloudfront_signed_url" "test" {
+  url         = %[1]q
+  key_pair_id = "K2JCJMDEHXQW5F"
+  private_key = %[2]q
+}
+`, rawURL, privateKey))
+}
+
+func testAccSignedURLEphemeralResourceConfig_customPolicy(rawURL, privateKey string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cloudfront_signed_url.test"),
+		fmt.Sprintf(`
+ephemeral "aws_cloudfront_signed_url" "test" {
+  url         = %[1]q
+  key_pair_id = "K2JCJMDEHXQW5F"
+  private_key = %[2]q
+  expires_in  = 300
+
+  custom_policy {
+    resource   = "https://d111111abcdef8.cloudfront.net/images/*"
+    ip_address = "192.0.2.0/24"
+  }
+}
+`, rawURL, privateKey))
+}
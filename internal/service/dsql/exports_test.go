@@ -7,5 +7,9 @@ package dsql
 var (
 	ResourceCluster = newClusterResource
 
-	FindClusterByID = findClusterByID
+	DBConnectAction                    = dbConnectAction
+	DBConnectAdminAction               = dbConnectAdminAction
+	DefaultDBConnectAuthTokenExpiresIn = defaultDBConnectAuthTokenExpiresIn
+	FindClusterByID                    = findClusterByID
+	GenerateDBConnectAuthToken         = generateDBConnectAuthToken
 )
@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/authtoken"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+)
+
+const (
+	// defaultDBConnectAuthTokenExpiresIn is the default lifetime of a DSQL authentication token.
+	// See https://docs.aws.amazon.com/aurora-dsql/latest/userguide/SECTION_authentication-token.html.
+	defaultDBConnectAuthTokenExpiresIn = 15 * time.Minute
+)
+
+// @EphemeralResource("aws_dsql_generate_db_connect_auth_token", name="Generate DB Connect Auth Token")
+func newGenerateDBConnectAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &generateDBConnectAuthTokenEphemeralResource{}, nil
+}
+
+type generateDBConnectAuthTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[generateDBConnectAuthTokenEphemeralResourceModel]
+}
+
+func (e *generateDBConnectAuthTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"admin": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to generate a token for the `admin` role. Defaults to `false`, which generates a token for a custom database role.",
+			},
+			"expiration": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Computed:    true,
+				Description: "The expiration time of the token in RFC3339 format.",
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 604800),
+				},
+				Description: "The duration, in seconds, for which the token is valid. Value can range from 1 to 604800 seconds (one week). Default is 900 seconds (15 minutes).",
+			},
+			"hostname": schema.StringAttribute{
+				Required:    true,
+				Description: "The hostname of the Aurora DSQL cluster endpoint.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The authentication token, which is used as the database role's password.",
+			},
+		},
+	}
+}
+
+func (e *generateDBConnectAuthTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data generateDBConnectAuthTokenEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	action := dbConnectAction
+	if data.Admin.ValueBool() {
+		action = dbConnectAdminAction
+	}
+	expiresIn := defaultDBConnectAuthTokenExpiresIn
+	if !data.ExpiresIn.IsNull() {
+		expiresIn = time.Duration(data.ExpiresIn.ValueInt64()) * time.Second
+	}
+	now := time.Now().UTC()
+
+	token, err := generateDBConnectAuthToken(ctx, fwflex.StringValueFromFramework(ctx, data.Hostname), e.Meta().Region(ctx), action, expiresIn, e.Meta().AwsConfig(ctx).Credentials, now)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	data.Expiration = timetypes.NewRFC3339TimeValue(now.Add(expiresIn))
+	data.Token = types.StringValue(token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type generateDBConnectAuthTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Admin      types.Bool        `tfsdk:"admin"`
+	Expiration timetypes.RFC3339 `tfsdk:"expiration"`
+	ExpiresIn  types.Int64       `tfsdk:"expires_in"`
+	Hostname   types.String      `tfsdk:"hostname"`
+	Token      types.String      `tfsdk:"token"`
+}
+
+const (
+	dbConnectAction      = "DbConnect"
+	dbConnectAdminAction = "DbConnectAdmin"
+)
+
+// generateDBConnectAuthToken returns an Aurora DSQL authentication token for the specified cluster endpoint, Region and action.
+// The token is a SigV4 presigned URL, without the scheme, and is generated locally; no AWS API is called.
+// This is equivalent to github.com/aws/aws-sdk-go-v2/feature/dsql/auth.GenerateDbConnectAuthToken and GenerateDBConnectAdminAuthToken.
+func generateDBConnectAuthToken(ctx context.Context, hostname, region, action string, expiresIn time.Duration, credentials aws.CredentialsProvider, signingTime time.Time) (string, error) {
+	query := url.Values{
+		"Action": []string{action},
+	}
+
+	return authtoken.Build(ctx, hostname, "dsql", region, query, expiresIn, credentials, signingTime)
+}
@@ -17,3 +17,15 @@ const (
 	clusterStatusInactive       = "INACTIVE"
 	clusterStatusProvisioning   = "PROVISIONING"
 )
+
+// https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task-lifecycle-explanation.html.
+const (
+	taskStatusActivating     = "ACTIVATING"
+	taskStatusDeactivating   = "DEACTIVATING"
+	taskStatusDeprovisioning = "DEPROVISIONING"
+	taskStatusPending        = "PENDING"
+	taskStatusProvisioning   = "PROVISIONING"
+	taskStatusRunning        = "RUNNING"
+	taskStatusStopped        = "STOPPED"
+	taskStatusStopping       = "STOPPING"
+)
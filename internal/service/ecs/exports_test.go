@@ -19,6 +19,7 @@ var (
 
 	ClusterNameFromARN                      = clusterNameFromARN
 	DaemonNameFromARN                       = daemonNameFromARN
+	EssentialContainersExitError            = essentialContainersExitError
 	FindCapacityProviderByARN               = findCapacityProviderByARN
 	FindClusterByNameOrARN                  = findClusterByNameOrARN
 	FindDaemonByARN                         = findDaemonByARN
@@ -28,6 +29,7 @@ var (
 	FindServiceNoTagsByTwoPartKey           = findServiceNoTagsByTwoPartKey
 	FindTag                                 = findTag
 	FindTaskDefinitionByFamilyOrARN         = findTaskDefinitionByFamilyOrARN
+	FindTaskByTwoPartKey                    = findTaskByTwoPartKey
 	FindTaskSetNoTagsByThreePartKey         = findTaskSetNoTagsByThreePartKey
 	RoleNameFromARN                         = roleNameFromARN
 	ServiceNameFromARN                      = serviceNameFromARN
@@ -0,0 +1,489 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// runTaskPollInterval defines polling cadence for the run task action.
+const runTaskPollInterval = 15 * time.Second
+
+// @Action(aws_ecs_run_task, name="Run Task")
+func newRunTaskAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &runTaskAction{}, nil
+}
+
+var (
+	_ action.Action                     = (*runTaskAction)(nil)
+	_ action.ActionWithConfigValidators = (*runTaskAction)(nil)
+)
+
+type runTaskAction struct {
+	framework.ActionWithModel[runTaskActionModel]
+}
+
+type runTaskActionModel struct {
+	framework.WithRegionModel
+	CapacityProviderStrategy fwtypes.ListNestedObjectValueOf[runTaskCapacityProviderStrategyItemModel] `tfsdk:"capacity_provider_strategy"`
+	Cluster                  types.String                                                              `tfsdk:"cluster"`
+	EnableExecuteCommand     types.Bool                                                                `tfsdk:"enable_execute_command"`
+	Group                    types.String                                                              `tfsdk:"group"`
+	LaunchType               fwtypes.StringEnum[awstypes.LaunchType]                                   `tfsdk:"launch_type"`
+	NetworkConfiguration     fwtypes.ListNestedObjectValueOf[runTaskNetworkConfigurationModel]         `tfsdk:"network_configuration" autoflex:"-"`
+	Overrides                fwtypes.ListNestedObjectValueOf[runTaskOverridesModel]                    `tfsdk:"overrides"`
+	PlatformVersion          types.String                                                              `tfsdk:"platform_version"`
+	PropagateTags            fwtypes.StringEnum[awstypes.PropagateTags]                                `tfsdk:"propagate_tags"`
+	StartedBy                types.String                                                              `tfsdk:"started_by"`
+	TaskDefinition           types.String                                                              `tfsdk:"task_definition"`
+	Timeout                  types.Int64                                                               `tfsdk:"timeout"`
+}
+
+type runTaskCapacityProviderStrategyItemModel struct {
+	Base             types.Int64  `tfsdk:"base"`
+	CapacityProvider types.String `tfsdk:"capacity_provider"`
+	Weight           types.Int64  `tfsdk:"weight"`
+}
+
+type runTaskNetworkConfigurationModel struct {
+	AssignPublicIP types.Bool          `tfsdk:"assign_public_ip"`
+	SecurityGroups fwtypes.SetOfString `tfsdk:"security_groups"`
+	Subnets        fwtypes.SetOfString `tfsdk:"subnets"`
+}
+
+type runTaskOverridesModel struct {
+	ContainerOverrides fwtypes.ListNestedObjectValueOf[runTaskContainerOverrideModel] `tfsdk:"container_overrides"`
+}
+
+type runTaskContainerOverrideModel struct {
+	Command     fwtypes.ListOfString                                      `tfsdk:"command"`
+	Environment fwtypes.ListNestedObjectValueOf[runTaskKeyValuePairModel] `tfsdk:"environment"`
+	Name        types.String                                              `tfsdk:"name"`
+}
+
+type runTaskKeyValuePairModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+func (a *runTaskAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an ECS task and waits for it to stop. The action fails if any essential container exits with a non-zero exit code.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Short name or ARN of the cluster to run the task on",
+				Required:    true,
+			},
+			"enable_execute_command": schema.BoolAttribute{
+				Description: "Whether to enable Amazon ECS Exec for the task",
+				Optional:    true,
+			},
+			"group": schema.StringAttribute{
+				Description: "Name of the task group to associate with the task",
+				Optional:    true,
+			},
+			"launch_type": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.LaunchType](),
+				Description: "Infrastructure to run the task on. Valid values: EC2, FARGATE, EXTERNAL, MANAGED_INSTANCES.",
+				Optional:    true,
+			},
+			"platform_version": schema.StringAttribute{
+				Description: "Platform version the task uses. Only applicable to tasks run on Fargate.",
+				Optional:    true,
+			},
+			names.AttrPropagateTags: schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.PropagateTags](),
+				Description: "Whether to propagate the tags from the task definition to the task. Valid values: TASK_DEFINITION, SERVICE, NONE.",
+				Optional:    true,
+			},
+			"started_by": schema.StringAttribute{
+				Description: "Optional tag to identify the task, e.g. the name of the deployment that ran it",
+				Optional:    true,
+			},
+			"task_definition": schema.StringAttribute{
+				Description: "Family, family:revision or ARN of the task definition to run",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the task to stop (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrCapacityProviderStrategy: schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[runTaskCapacityProviderStrategyItemModel](ctx),
+				Description: "Capacity provider strategy to use for the task. Conflicts with launch_type.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(20),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"base": schema.Int64Attribute{
+							Description: "Minimum number of tasks to run on the capacity provider",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 100000),
+							},
+						},
+						"capacity_provider": schema.StringAttribute{
+							Description: "Short name of the capacity provider",
+							Required:    true,
+						},
+						names.AttrWeight: schema.Int64Attribute{
+							Description: "Relative percentage of tasks to run on the capacity provider",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 1000),
+							},
+						},
+					},
+				},
+			},
+			names.AttrNetworkConfiguration: schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[runTaskNetworkConfigurationModel](ctx),
+				Description: "Network configuration for tasks using the awsvpc network mode",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"assign_public_ip": schema.BoolAttribute{
+							Description: "Whether to assign a public IP address to the task's elastic network interface",
+							Optional:    true,
+						},
+						names.AttrSecurityGroups: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							Description: "Security groups associated with the task",
+							Optional:    true,
+						},
+						names.AttrSubnets: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							Description: "Subnets associated with the task",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"overrides": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[runTaskOverridesModel](ctx),
+				Description: "Overrides for the task definition",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"container_overrides": schema.ListNestedBlock{
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[runTaskContainerOverrideModel](ctx),
+							Description: "Overrides for individual containers",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"command": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										Description: "Command to run in place of the container definition's command",
+										Optional:    true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Description: "Name of the container to override",
+										Required:    true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrEnvironment: schema.ListNestedBlock{
+										CustomType:  fwtypes.NewListNestedObjectTypeOf[runTaskKeyValuePairModel](ctx),
+										Description: "Environment variables to add to or override in the container",
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrName: schema.StringAttribute{
+													Description: "Environment variable name",
+													Required:    true,
+												},
+												names.AttrValue: schema.StringAttribute{
+													Description: "Environment variable value",
+													Required:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *runTaskAction) ConfigValidators(context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.Conflicting(
+			path.MatchRoot(names.AttrCapacityProviderStrategy),
+			path.MatchRoot("launch_type"),
+		),
+	}
+}
+
+func (a *runTaskAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config runTaskActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().ECSClient(ctx)
+
+	cluster := fwflex.StringValueFromFramework(ctx, config.Cluster)
+	taskDefinition := fwflex.StringValueFromFramework(ctx, config.TaskDefinition)
+	timeout := fwactions.TimeoutOr(config.Timeout, 30*time.Minute)
+
+	tflog.Info(ctx, "Starting ECS run task action", map[string]any{
+		"cluster":         cluster,
+		"task_definition": taskDefinition,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Running ECS task %s on cluster %s...", taskDefinition, cluster)
+
+	var input ecs.RunTaskInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkConfiguration, diags := config.NetworkConfiguration.ToPtr(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if networkConfiguration != nil {
+		input.NetworkConfiguration = expandRunTaskNetworkConfiguration(ctx, networkConfiguration)
+	}
+
+	output, err := conn.RunTask(ctx, &input)
+	if err == nil && len(output.Failures) > 0 {
+		err = errors.Join(tfslices.ApplyToAll(output.Failures, func(v awstypes.Failure) error {
+			return failureError(&v)
+		})...)
+	}
+	if err == nil && len(output.Tasks) == 0 {
+		err = tfresource.NewEmptyResultError()
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Run ECS Task",
+			fmt.Sprintf("Could not run ECS task %s on cluster %s: %s", taskDefinition, cluster, err),
+		)
+		return
+	}
+
+	taskARN := aws.ToString(output.Tasks[0].TaskArn)
+	cb(ctx, "ECS task %s started, waiting for it to stop...", taskARN)
+
+	// Tasks such as database migrations can run for a long time but move through
+	// their lifecycle states quickly, so poll at a fixed interval.
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Task], error) {
+		task, err := findTaskByTwoPartKey(ctx, conn, taskARN, cluster)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Task]{}, fmt.Errorf("describing task: %w", err)
+		}
+		return actionwait.FetchResult[*awstypes.Task]{Status: actionwait.Status(aws.ToString(task.LastStatus)), Value: task}, nil
+	}, actionwait.Options[*awstypes.Task]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(runTaskPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{taskStatusStopped},
+		TransitionalStates: []actionwait.Status{
+			taskStatusProvisioning,
+			taskStatusPending,
+			taskStatusActivating,
+			taskStatusRunning,
+			taskStatusDeactivating,
+			taskStatusStopping,
+			taskStatusDeprovisioning,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "ECS task %s is currently in state '%s', continuing to wait for '%s'...", taskARN, fr.Status, taskStatusStopped)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for ECS Task to Stop",
+				fmt.Sprintf("ECS task %s did not stop within %s. The task has not been stopped and may still be running.", taskARN, timeout),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected ECS Task State",
+				fmt.Sprintf("ECS task %s entered unexpected state: %s", taskARN, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for ECS Task to Stop",
+				fmt.Sprintf("Error while waiting for ECS task %s to stop: %s", taskARN, err),
+			)
+		}
+		return
+	}
+
+	task := fr.Value
+	for _, container := range task.Containers {
+		if exitCode := container.ExitCode; exitCode != nil {
+			cb(ctx, "Container %s exited with code %d", aws.ToString(container.Name), aws.ToInt32(exitCode))
+		}
+	}
+
+	// Essential is a property of the container definition, not of the task's containers.
+	taskDefinitionOutput, _, err := findTaskDefinition(ctx, conn, &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: task.TaskDefinitionArn,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe ECS Task Definition",
+			fmt.Sprintf("Could not describe task definition %s of ECS task %s: %s", aws.ToString(task.TaskDefinitionArn), taskARN, err),
+		)
+		return
+	}
+
+	if err := essentialContainersExitError(task, taskDefinitionOutput.ContainerDefinitions); err != nil {
+		resp.Diagnostics.AddError(
+			"ECS Task Failed",
+			fmt.Sprintf("ECS task %s stopped (%s: %s):\n%s", taskARN, task.StopCode, aws.ToString(task.StoppedReason), err),
+		)
+		return
+	}
+
+	cb(ctx, "ECS task %s completed successfully", taskARN)
+
+	tflog.Info(ctx, "ECS run task action completed successfully", map[string]any{
+		"task_arn": taskARN,
+	})
+}
+
+func expandRunTaskNetworkConfiguration(ctx context.Context, data *runTaskNetworkConfigurationModel) *awstypes.NetworkConfiguration {
+	apiObject := &awstypes.AwsVpcConfiguration{
+		AssignPublicIp: awstypes.AssignPublicIpDisabled,
+		SecurityGroups: fwflex.ExpandFrameworkStringValueSet(ctx, data.SecurityGroups),
+		Subnets:        fwflex.ExpandFrameworkStringValueSet(ctx, data.Subnets),
+	}
+
+	if data.AssignPublicIP.ValueBool() {
+		apiObject.AssignPublicIp = awstypes.AssignPublicIpEnabled
+	}
+
+	return &awstypes.NetworkConfiguration{
+		AwsvpcConfiguration: apiObject,
+	}
+}
+
+// essentialContainersExitError returns an error describing each essential container
+// of a stopped task that did not exit with exit code 0.
+// Containers are essential unless their container definition says otherwise.
+func essentialContainersExitError(task *awstypes.Task, containerDefinitions []awstypes.ContainerDefinition) error {
+	nonEssential := make(map[string]bool)
+	for _, v := range containerDefinitions {
+		if v.Essential != nil && !aws.ToBool(v.Essential) {
+			nonEssential[aws.ToString(v.Name)] = true
+		}
+	}
+
+	var errs []error
+	for _, v := range task.Containers {
+		name := aws.ToString(v.Name)
+		if nonEssential[name] {
+			continue
+		}
+
+		switch exitCode := v.ExitCode; {
+		case exitCode == nil:
+			errs = append(errs, fmt.Errorf("essential container (%s) did not exit: %s", name, aws.ToString(v.Reason)))
+		case aws.ToInt32(exitCode) != 0:
+			err := fmt.Errorf("essential container (%s) exited with code %d", name, aws.ToInt32(exitCode))
+			if reason := aws.ToString(v.Reason); reason != "" {
+				err = fmt.Errorf("%w: %s", err, reason)
+			}
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func findTask(ctx context.Context, conn *ecs.Client, input *ecs.DescribeTasksInput) (*awstypes.Task, error) {
+	output, err := findTasks(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findTasks(ctx context.Context, conn *ecs.Client, input *ecs.DescribeTasksInput) ([]awstypes.Task, error) {
+	output, err := conn.DescribeTasks(ctx, input)
+
+	if errs.IsA[*awstypes.ClusterNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	for _, v := range output.Failures {
+		if aws.ToString(v.Reason) == failureReasonMissing {
+			return nil, &retry.NotFoundError{
+				LastError: failureError(&v),
+			}
+		}
+	}
+
+	return output.Tasks, nil
+}
+
+func findTaskByTwoPartKey(ctx context.Context, conn *ecs.Client, taskARN, clusterNameOrARN string) (*awstypes.Task, error) {
+	input := ecs.DescribeTasksInput{
+		Cluster: aws.String(clusterNameOrARN),
+		Tasks:   []string{taskARN},
+	}
+
+	return findTask(ctx, conn, &input)
+}
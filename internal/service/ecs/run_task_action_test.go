@@ -0,0 +1,353 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestEssentialContainersExitError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		task                 *awstypes.Task
+		containerDefinitions []awstypes.ContainerDefinition
+		expectedError        string
+	}{
+		"success": {
+			task: &awstypes.Task{
+				Containers: []awstypes.Container{
+					{Name: aws.String("app"), ExitCode: aws.Int32(0)},
+				},
+			},
+		},
+		"non-zero exit code": {
+			task: &awstypes.Task{
+				Containers: []awstypes.Container{
+					{Name: aws.String("app"), ExitCode: aws.Int32(1)},
+				},
+			},
+			expectedError: "essential container (app) exited with code 1",
+		},
+		"non-essential container": {
+			task: &awstypes.Task{
+				Containers: []awstypes.Container{
+					{Name: aws.String("app"), ExitCode: aws.Int32(0)},
+					{Name: aws.String("sidecar"), ExitCode: aws.Int32(137)},
+				},
+			},
+			containerDefinitions: []awstypes.ContainerDefinition{
+				{Name: aws.String("app")},
+				{Name: aws.String("sidecar"), Essential: aws.Bool(false)},
+			},
+		},
+		"explicitly essential container": {
+			task: &awstypes.Task{
+				Containers: []awstypes.Container{
+					{Name: aws.String("app"), ExitCode: aws.Int32(2), Reason: aws.String("OutOfMemoryError")},
+				},
+			},
+			containerDefinitions: []awstypes.ContainerDefinition{
+				{Name: aws.String("app"), Essential: aws.Bool(true)},
+			},
+			expectedError: "essential container (app) exited with code 2: OutOfMemoryError",
+		},
+		"container did not exit": {
+			task: &awstypes.Task{
+				Containers: []awstypes.Container{
+					{Name: aws.String("app"), Reason: aws.String("CannotPullContainerError")},
+				},
+			},
+			expectedError: "essential container (app) did not exit: CannotPullContainerError",
+		},
+		"multiple failures": {
+			task: &awstypes.Task{
+				Containers: []awstypes.Container{
+					{Name: aws.String("app"), ExitCode: aws.Int32(1)},
+					{Name: aws.String("migrate"), ExitCode: aws.Int32(3)},
+				},
+			},
+			expectedError: "essential container (app) exited with code 1\nessential container (migrate) exited with code 3",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfecs.EssentialContainersExitError(testCase.task, testCase.containerDefinitions)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if got, want := err.Error(), testCase.expectedError; got != want {
+				t.Errorf("got error %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAccECSRunTaskAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ECSEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunTaskActionConfig_basic(rName, `["true"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRunTaskActionStopped(ctx, t, rName, 0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSRunTaskAction_nonZeroExitCode(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ECSEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRunTaskActionConfig_basic(rName, `["sh", "-c", "exit 3"]`),
+				ExpectError: regexache.MustCompile(`essential container \(test\) exited with code 3`),
+			},
+		},
+	})
+}
+
+func TestAccECSRunTaskAction_launchTypeConflictsWithCapacityProviderStrategy(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ECSEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRunTaskActionConfig_launchTypeAndCapacityProviderStrategy(),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccCheckRunTaskActionStopped(ctx context.Context, t *testing.T, rName string, exitCode int32) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).ECSClient(ctx)
+
+		input := ecs.ListTasksInput{
+			Cluster:       aws.String(rName),
+			DesiredStatus: awstypes.DesiredStatusStopped,
+			StartedBy:     aws.String(rName),
+		}
+		output, err := conn.ListTasks(ctx, &input)
+		if err != nil {
+			return err
+		}
+
+		if n := len(output.TaskArns); n != 1 {
+			return fmt.Errorf("expected 1 stopped ECS Task started by %s, got %d", rName, n)
+		}
+
+		task, err := tfecs.FindTaskByTwoPartKey(ctx, conn, output.TaskArns[0], rName)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range task.Containers {
+			if got := aws.ToInt32(v.ExitCode); got != exitCode {
+				return fmt.Errorf("ECS Task (%s) container (%s) exit code = %d, want %d", aws.ToString(task.TaskArn), aws.ToString(v.Name), got, exitCode)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccRunTaskActionConfig_basic(rName, command string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigVPCWithSubnets(rName, 2),
+		fmt.Sprintf(`
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route" "test" {
+  route_table_id         = aws_vpc.test.main_route_table_id
+  destination_cidr_block = "0.0.0.0/0"
+  gateway_id             = aws_internet_gateway.test.id
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  egress {
+    protocol    = "-1"
+    from_port   = 0
+    to_port     = 0
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_cluster_capacity_providers" "test" {
+  cluster_name       = aws_ecs_cluster.test.name
+  capacity_providers = ["FARGATE"]
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = jsonencode([
+    {
+      name      = "test"
+      image     = "public.ecr.aws/docker/library/busybox:latest"
+      command   = ["sleep", "1"]
+      essential = true
+    }
+  ])
+}
+
+action "aws_ecs_run_task" "test" {
+  config {
+    cluster         = aws_ecs_cluster.test.name
+    task_definition = aws_ecs_task_definition.test.arn
+    launch_type     = "FARGATE"
+    started_by      = %[1]q
+    timeout         = 600
+
+    network_configuration {
+      subnets          = aws_subnet.test[*].id
+      security_groups  = [aws_security_group.test.id]
+      assign_public_ip = true
+    }
+
+    overrides {
+      container_overrides {
+        name    = "test"
+        command = %[2]s
+
+        environment {
+          name  = "RUN_TASK_ACTION"
+          value = "true"
+        }
+      }
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.aws_ecs_run_task.test]
+    }
+  }
+
+  depends_on = [aws_ecs_cluster_capacity_providers.test, aws_route.test]
+}
+`, rName, command))
+}
+
+func testAccRunTaskActionConfig_launchTypeAndCapacityProviderStrategy() string {
+	return `
+action "aws_ecs_run_task" "test" {
+  config {
+    cluster         = "test"
+    task_definition = "test"
+    launch_type     = "FARGATE"
+
+    capacity_provider_strategy {
+      capacity_provider = "FARGATE"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.aws_ecs_run_task.test]
+    }
+  }
+}
+`
+}
@@ -15,6 +15,8 @@ var (
 type (
 	IAMPolicyDoc                   = iamPolicyDoc
 	IAMPolicyStatement             = iamPolicyStatement
+	IAMPolicyStatementCondition    = iamPolicyStatementCondition
+	IAMPolicyStatementConditionSet = iamPolicyStatementConditionSet
 	IAMPolicyStatementPrincipal    = iamPolicyStatementPrincipal
 	IAMPolicyStatementPrincipalSet = iamPolicyStatementPrincipalSet
 )
@@ -31,6 +31,7 @@ import (
 // @IdentityAttribute("policy_arn")
 // @IdAttrFormat("{role}/{policy_arn}")
 // @ImportIDHandler("rolePolicyAttachmentImportID")
+// @ConcurrencyLimit(10)
 // @Testing(preIdentityVersion="6.0.0")
 func resourceRolePolicyAttachment() *schema.Resource {
 	return &schema.Resource{
@@ -354,10 +354,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			},
 		},
 		{
-			Factory:  resourceRolePolicyAttachment,
-			TypeName: "aws_iam_role_policy_attachment",
-			Name:     "Role Policy Attachment",
-			Region:   inttypes.ResourceRegionDisabled(),
+			Factory:          resourceRolePolicyAttachment,
+			TypeName:         "aws_iam_role_policy_attachment",
+			Name:             "Role Policy Attachment",
+			Region:           inttypes.ResourceRegionDisabled(),
+			ConcurrencyLimit: 10,
 			Identity: inttypes.GlobalParameterizedIdentity([]inttypes.IdentityAttribute{
 				inttypes.StringIdentityAttribute(names.AttrRole, true),
 				inttypes.StringIdentityAttribute("policy_arn", true),
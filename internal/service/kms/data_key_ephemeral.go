@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_kms_data_key", name="Data Key")
+func newDataKeyEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &dataKeyEphemeralResource{}, nil
+}
+
+type dataKeyEphemeralResource struct {
+	framework.EphemeralResourceWithModel[dataKeyEphemeralResourceModel]
+}
+
+func (e *dataKeyEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ciphertext_blob": schema.StringAttribute{
+				Computed:    true,
+				Description: "The base64-encoded data key, encrypted under the KMS key.",
+			},
+			"context": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				Optional:    true,
+				Description: "The encryption context to use when encrypting the data key. The same context must be supplied to decrypt it.",
+			},
+			"grant_tokens": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Optional:    true,
+				Description: "A list of grant tokens.",
+			},
+			names.AttrKeyID: schema.StringAttribute{
+				Required:    true,
+				Description: "The symmetric encryption KMS key that encrypts the data key, specified as a key ID, key ARN, alias name or alias ARN.",
+			},
+			"key_spec": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.DataKeySpec](),
+				Optional:    true,
+				Description: "The length of the data key. Defaults to `AES_256` unless `number_of_bytes` is specified.",
+			},
+			"number_of_bytes": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 1024),
+					int32validator.ConflictsWith(path.MatchRoot("key_spec")),
+				},
+				Description: "The length of the data key in bytes.",
+			},
+			"plaintext": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64-encoded plaintext data key.",
+			},
+		},
+	}
+}
+
+func (e *dataKeyEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data dataKeyEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().KMSClient(ctx)
+
+	var input kms.GenerateDataKeyInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.EncryptionContext = fwflex.ExpandFrameworkStringValueMap(ctx, data.Context)
+	if input.KeySpec == "" && input.NumberOfBytes == nil {
+		input.KeySpec = awstypes.DataKeySpecAes256
+	}
+
+	output, err := conn.GenerateDataKey(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, data.KeyID.ValueString())
+		return
+	}
+
+	data.CiphertextBlob = types.StringValue(inttypes.Base64Encode(output.CiphertextBlob))
+	data.Plaintext = types.StringValue(inttypes.Base64Encode(output.Plaintext))
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type dataKeyEphemeralResourceModel struct {
+	framework.WithRegionModel
+	CiphertextBlob types.String                             `tfsdk:"ciphertext_blob" autoflex:"-"`
+	Context        fwtypes.MapOfString                      `tfsdk:"context" autoflex:"-"`
+	GrantTokens    fwtypes.ListOfString                     `tfsdk:"grant_tokens"`
+	KeyID          types.String                             `tfsdk:"key_id"`
+	KeySpec        fwtypes.StringEnum[awstypes.DataKeySpec] `tfsdk:"key_spec"`
+	NumberOfBytes  types.Int32                              `tfsdk:"number_of_bytes"`
+	Plaintext      types.String                             `tfsdk:"plaintext" autoflex:"-"`
+}
@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_kms_data_key_pair", name="Data Key Pair")
+func newDataKeyPairEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &dataKeyPairEphemeralResource{}, nil
+}
+
+type dataKeyPairEphemeralResource struct {
+	framework.EphemeralResourceWithModel[dataKeyPairEphemeralResourceModel]
+}
+
+func (e *dataKeyPairEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"context": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				Optional:    true,
+				Description: "The encryption context to use when encrypting the private key. The same context must be supplied to decrypt it.",
+			},
+			"grant_tokens": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Optional:    true,
+				Description: "A list of grant tokens.",
+			},
+			names.AttrKeyID: schema.StringAttribute{
+				Required:    true,
+				Description: "The symmetric encryption KMS key that encrypts the private key, specified as a key ID, key ARN, alias name or alias ARN.",
+			},
+			"key_pair_spec": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.DataKeyPairSpec](),
+				Required:    true,
+				Description: "The type of data key pair to generate.",
+			},
+			"private_key_ciphertext_blob": schema.StringAttribute{
+				Computed:    true,
+				Description: "The base64-encoded private key, encrypted under the KMS key.",
+			},
+			"private_key_plaintext": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64-encoded plaintext private key, a DER-encoded PKCS #8 PrivateKeyInfo.",
+			},
+			names.AttrPublicKey: schema.StringAttribute{
+				Computed:    true,
+				Description: "The base64-encoded public key, a DER-encoded X.509 SubjectPublicKeyInfo.",
+			},
+		},
+	}
+}
+
+func (e *dataKeyPairEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data dataKeyPairEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().KMSClient(ctx)
+
+	var input kms.GenerateDataKeyPairInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.EncryptionContext = fwflex.ExpandFrameworkStringValueMap(ctx, data.Context)
+
+	output, err := conn.GenerateDataKeyPair(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, data.KeyID.ValueString())
+		return
+	}
+
+	data.PrivateKeyCiphertextBlob = types.StringValue(inttypes.Base64Encode(output.PrivateKeyCiphertextBlob))
+	data.PrivateKeyPlaintext = types.StringValue(inttypes.Base64Encode(output.PrivateKeyPlaintext))
+	data.PublicKey = types.StringValue(inttypes.Base64Encode(output.PublicKey))
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type dataKeyPairEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Context                  fwtypes.MapOfString                          `tfsdk:"context" autoflex:"-"`
+	GrantTokens              fwtypes.ListOfString                         `tfsdk:"grant_tokens"`
+	KeyID                    types.String                                 `tfsdk:"key_id"`
+	KeyPairSpec              fwtypes.StringEnum[awstypes.DataKeyPairSpec] `tfsdk:"key_pair_spec"`
+	PrivateKeyCiphertextBlob types.String                                 `tfsdk:"private_key_ciphertext_blob" autoflex:"-"`
+	PrivateKeyPlaintext      types.String                                 `tfsdk:"private_key_plaintext" autoflex:"-"`
+	PublicKey                types.String                                 `tfsdk:"public_key" autoflex:"-"`
+}
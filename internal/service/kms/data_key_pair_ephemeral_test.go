@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKMSDataKeyPairEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.KMSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataKeyPairEphemeralResourceConfig_basic(rName, "ECC_NIST_P256"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("key_pair_spec"), knownvalue.StringExact("ECC_NIST_P256")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("private_key_ciphertext_blob"), knownvalue.NotNull()),
+					// DER-encoded PKCS #8 PrivateKeyInfo.
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("private_key_plaintext"), knownvalue.StringRegexp(regexache.MustCompile(`^MI[0-9A-Za-z+/]+={0,2}$`))),
+					// DER-encoded X.509 SubjectPublicKeyInfo.
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrPublicKey), knownvalue.StringRegexp(regexache.MustCompile(`^MF[0-9A-Za-z+/]+={0,2}$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccKMSDataKeyPairEphemeral_contextAndGrantTokens(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.KMSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataKeyPairEphemeralResourceConfig_contextAndGrantTokens(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("key_pair_spec"), knownvalue.StringExact("RSA_2048")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("private_key_ciphertext_blob"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("private_key_plaintext"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrPublicKey), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccDataKeyPairEphemeralResourceConfig_basic(rName, keyPairSpec string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_kms_data_key_pair.test"),
+		testAccDataKeyEphemeralResourceConfig_base(rName),
+		fmt.Sprintf(`
+ephemeral "aws_kms_data_key_pair" "test" {
+  key_id        = aws_kms_key.test.key_id
+  key_pair_spec = %[1]q
+}
+`, keyPairSpec))
+}
+
+func testAccDataKeyPairEphemeralResourceConfig_contextAndGrantTokens(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_kms_data_key_pair.test"),
+		testAccDataKeyEphemeralResourceConfig_base(rName),
+		`
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_kms_grant" "test" {
+  key_id            = aws_kms_key.test.key_id
+  grantee_principal = data.aws_iam_session_context.current.issuer_arn
+  operations        = ["GenerateDataKeyPair"]
+
+  constraints {
+    encryption_context_equals = {
+      purpose = "test"
+    }
+  }
+}
+
+ephemeral "aws_kms_data_key_pair" "test" {
+  key_id        = aws_kms_key.test.arn
+  key_pair_spec = "RSA_2048"
+  grant_tokens  = [aws_kms_grant.test.grant_token]
+
+  context = {
+    purpose = "test"
+  }
+}
+`)
+}
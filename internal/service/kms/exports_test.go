@@ -21,6 +21,7 @@ var (
 	FindGrantByTwoPartKey     = findGrantByTwoPartKey
 	FindKeyByID               = findKeyByID
 	FindKeyPolicyByTwoPartKey = findKeyPolicyByTwoPartKey
+	FindKeyRotations          = findKeyRotations
 	GrantParseResourceID      = grantParseResourceID
 	KeyARNOrIDEqual           = keyARNOrIDEqual
 	PropagationTimeout        = propagationTimeout
@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -451,6 +452,16 @@ func findKeyPolicyByTwoPartKey(ctx context.Context, conn *kms.Client, keyID, pol
 }
 
 func findKeyRotationEnabledByKeyID(ctx context.Context, conn *kms.Client, keyID string) (*bool, *int32, error) {
+	output, err := findKeyRotationStatusByKeyID(ctx, conn, keyID)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return aws.Bool(output.KeyRotationEnabled), output.RotationPeriodInDays, nil
+}
+
+func findKeyRotationStatusByKeyID(ctx context.Context, conn *kms.Client, keyID string) (*kms.GetKeyRotationStatusOutput, error) {
 	input := kms.GetKeyRotationStatusInput{
 		KeyId: aws.String(keyID),
 	}
@@ -458,20 +469,47 @@ func findKeyRotationEnabledByKeyID(ctx context.Context, conn *kms.Client, keyID
 	output, err := conn.GetKeyRotationStatus(ctx, &input)
 
 	if errs.IsA[*awstypes.NotFoundException](err) {
-		return nil, nil, &retry.NotFoundError{
+		return nil, &retry.NotFoundError{
 			LastError: err,
 		}
 	}
 
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	if output == nil {
-		return nil, nil, tfresource.NewEmptyResultError()
+		return nil, tfresource.NewEmptyResultError()
 	}
 
-	return aws.Bool(output.KeyRotationEnabled), output.RotationPeriodInDays, nil
+	return output, nil
+}
+
+func findKeyRotations(ctx context.Context, conn *kms.Client, input *kms.ListKeyRotationsInput, filter tfslices.Predicate[*awstypes.RotationsListEntry]) ([]awstypes.RotationsListEntry, error) {
+	var output []awstypes.RotationsListEntry
+
+	pages := kms.NewListKeyRotationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Rotations {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
 }
 
 func updateKeyDescription(ctx context.Context, conn *kms.Client, resourceTypeName, keyID, description string) error {
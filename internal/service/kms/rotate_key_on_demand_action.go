@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	keyRotationStatusCompleted  actionwait.Status = "COMPLETED"
+	keyRotationStatusInProgress actionwait.Status = "IN_PROGRESS"
+)
+
+// @Action(aws_kms_rotate_key_on_demand, name="Rotate Key On Demand")
+func newRotateKeyOnDemandAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &rotateKeyOnDemandAction{}, nil
+}
+
+var (
+	_ action.Action = (*rotateKeyOnDemandAction)(nil)
+)
+
+type rotateKeyOnDemandAction struct {
+	framework.ActionWithModel[rotateKeyOnDemandActionModel]
+}
+
+type rotateKeyOnDemandActionModel struct {
+	framework.WithRegionModel
+	KeyID   types.String `tfsdk:"key_id"`
+	Timeout types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *rotateKeyOnDemandAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Immediately rotates the key material of a KMS key and waits for the rotation to complete.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrKeyID: schema.StringAttribute{
+				Description: "The ID or ARN of the KMS key to rotate",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the rotation to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+	}
+}
+
+func (a *rotateKeyOnDemandAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config rotateKeyOnDemandActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().KMSClient(ctx)
+
+	keyID := fwflex.StringValueFromFramework(ctx, config.KeyID)
+	timeout := fwactions.TimeoutOr(config.Timeout, 30*time.Minute)
+
+	tflog.Info(ctx, "Starting KMS rotate key on demand action", map[string]any{
+		names.AttrKeyID:   keyID,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting on-demand rotation of KMS key %s...", keyID)
+
+	// The rotation that the request starts is the first on-demand rotation after any that have already completed.
+	// Rotation dates are recorded by KMS, so no client clock is involved.
+	rotations, err := findKeyRotations(ctx, conn, &kms.ListKeyRotationsInput{KeyId: aws.String(keyID)}, isOnDemandKeyRotation)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Rotate KMS Key",
+			fmt.Sprintf("Could not list rotations of KMS key %s: %s", keyID, err),
+		)
+		return
+	}
+
+	var previousRotationDate time.Time
+	for _, v := range rotations {
+		if v := aws.ToTime(v.RotationDate); v.After(previousRotationDate) {
+			previousRotationDate = v
+		}
+	}
+
+	input := kms.RotateKeyOnDemandInput{
+		KeyId: aws.String(keyID),
+	}
+	if _, err := conn.RotateKeyOnDemand(ctx, &input); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Rotate KMS Key",
+			fmt.Sprintf("Could not start on-demand rotation of KMS key %s: %s", keyID, err),
+		)
+		return
+	}
+
+	cb(ctx, "On-demand rotation of KMS key %s started, waiting for it to complete...", keyID)
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.RotationsListEntry], error) {
+		input := kms.ListKeyRotationsInput{
+			KeyId: aws.String(keyID),
+		}
+		rotations, err := findKeyRotations(ctx, conn, &input, func(v *awstypes.RotationsListEntry) bool {
+			return isOnDemandKeyRotation(v) && aws.ToTime(v.RotationDate).After(previousRotationDate)
+		})
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.RotationsListEntry]{}, fmt.Errorf("listing key rotations: %w", err)
+		}
+		if len(rotations) == 0 {
+			return actionwait.FetchResult[*awstypes.RotationsListEntry]{Status: keyRotationStatusInProgress}, nil
+		}
+		return actionwait.FetchResult[*awstypes.RotationsListEntry]{Status: keyRotationStatusCompleted, Value: &rotations[len(rotations)-1]}, nil
+	}, actionwait.Options[*awstypes.RotationsListEntry]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(10 * time.Second),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{keyRotationStatusCompleted},
+		TransitionalStates: []actionwait.Status{keyRotationStatusInProgress},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "On-demand rotation of KMS key %s is still in progress...", keyID)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for KMS Key Rotation",
+				fmt.Sprintf("On-demand rotation of KMS key %s did not complete within %s. The rotation may still complete.", keyID, timeout),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for KMS Key Rotation",
+				fmt.Sprintf("Error while waiting for on-demand rotation of KMS key %s to complete: %s", keyID, err),
+			)
+		}
+		return
+	}
+
+	rotation := fr.Value
+	cb(ctx, "On-demand rotation of KMS key %s completed at %s (key material: %s)", keyID, aws.ToTime(rotation.RotationDate).Format(time.RFC3339), aws.ToString(rotation.KeyMaterialId))
+
+	tflog.Info(ctx, "KMS rotate key on demand action completed successfully", map[string]any{
+		names.AttrKeyID:   keyID,
+		"key_material_id": aws.ToString(rotation.KeyMaterialId),
+	})
+}
+
+func isOnDemandKeyRotation(v *awstypes.RotationsListEntry) bool {
+	return v.RotationType == awstypes.RotationTypeOnDemand
+}
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKMSRotateKeyOnDemandAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	var key awstypes.KeyMetadata
+	resourceName := "aws_kms_key.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckKeyDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotateKeyOnDemandActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, t, resourceName, &key),
+					testAccCheckRotateKeyOnDemandActionRotated(ctx, t, &key),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRotateKeyOnDemandActionRotated(ctx context.Context, t *testing.T, key *awstypes.KeyMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).KMSClient(ctx)
+
+		input := kms.ListKeyRotationsInput{
+			KeyId: key.KeyId,
+		}
+		output, err := tfkms.FindKeyRotations(ctx, conn, &input, func(v *awstypes.RotationsListEntry) bool {
+			return v.RotationType == awstypes.RotationTypeOnDemand
+		})
+		if err != nil {
+			return err
+		}
+
+		if got, want := len(output), 1; got != want {
+			return fmt.Errorf("KMS Key (%s) on-demand rotations = %d, want %d", aws.ToString(key.KeyId), got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccRotateKeyOnDemandActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+action "aws_kms_rotate_key_on_demand" "test" {
+  config {
+    key_id = aws_kms_key.test.key_id
+  }
+}
+
+resource "terraform_data" "test" {
+  input = aws_kms_key.test.key_id
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.aws_kms_rotate_key_on_demand.test]
+    }
+  }
+}
+`, rName)
+}
@@ -22,14 +22,43 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
+	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newRotateKeyOnDemandAction,
+			TypeName: "aws_kms_rotate_key_on_demand",
+			Name:     "Rotate Key On Demand",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+	}
+}
+
 func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
 	return []*inttypes.ServicePackageEphemeralResource{
+		{
+			Factory:  newDataKeyEphemeralResource,
+			TypeName: "aws_kms_data_key",
+			Name:     "Data Key",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newDataKeyPairEphemeralResource,
+			TypeName: "aws_kms_data_key_pair",
+			Name:     "Data Key Pair",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
 		{
 			Factory:  newSecretsEphemeralResource,
 			TypeName: "aws_kms_secrets",
 			Name:     "Secrets",
 			Region:   inttypes.ResourceRegionDefault(),
 		},
+		{
+			Factory:  newSignatureEphemeralResource,
+			TypeName: "aws_kms_signature",
+			Name:     "Signature",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
 	}
 }
 
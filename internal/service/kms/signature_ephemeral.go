@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_kms_signature", name="Signature")
+func newSignatureEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &signatureEphemeralResource{}, nil
+}
+
+type signatureEphemeralResource struct {
+	framework.EphemeralResourceWithModel[signatureEphemeralResourceModel]
+}
+
+func (e *signatureEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"grant_tokens": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Optional:    true,
+				Description: "A list of grant tokens.",
+			},
+			names.AttrKeyID: schema.StringAttribute{
+				Required:    true,
+				Description: "The asymmetric KMS key with a key usage of `SIGN_VERIFY` used to sign the message, specified as a key ID, key ARN, alias name or alias ARN.",
+			},
+			names.AttrMessage: schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The base64-encoded message or message digest to sign.",
+			},
+			"message_type": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.MessageType](),
+				Optional:    true,
+				Description: "Whether `message` is the message itself (`RAW`) or a digest of it (`DIGEST`). Defaults to `RAW`.",
+			},
+			"signature": schema.StringAttribute{
+				Computed:    true,
+				Description: "The base64-encoded signature.",
+			},
+			"signing_algorithm": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.SigningAlgorithmSpec](),
+				Required:    true,
+				Description: "The signing algorithm, which must be supported by the KMS key.",
+			},
+		},
+	}
+}
+
+func (e *signatureEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data signatureEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().KMSClient(ctx)
+
+	var input kms.SignInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	message, err := inttypes.Base64Decode(data.Message.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			path.Root(names.AttrMessage),
+			"invalid base64 value for message",
+			err.Error(),
+		)
+		return
+	}
+	input.Message = message
+
+	output, err := conn.Sign(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, data.KeyID.ValueString())
+		return
+	}
+
+	data.Signature = types.StringValue(inttypes.Base64Encode(output.Signature))
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type signatureEphemeralResourceModel struct {
+	framework.WithRegionModel
+	GrantTokens      fwtypes.ListOfString                              `tfsdk:"grant_tokens"`
+	KeyID            types.String                                      `tfsdk:"key_id"`
+	Message          types.String                                      `tfsdk:"message" autoflex:"-"`
+	MessageType      fwtypes.StringEnum[awstypes.MessageType]          `tfsdk:"message_type"`
+	Signature        types.String                                      `tfsdk:"signature" autoflex:"-"`
+	SigningAlgorithm fwtypes.StringEnum[awstypes.SigningAlgorithmSpec] `tfsdk:"signing_algorithm"`
+}
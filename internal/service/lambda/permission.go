@@ -38,6 +38,7 @@ var functionRegexp = `^(arn:[\w-]+:lambda:)?(` + inttypes.CanonicalRegionPattern
 // @IdentityAttribute("statement_id")
 // @IdentityAttribute("qualifier", optional="true")
 // @ImportIDHandler("permissionImportID")
+// @ConcurrencyLimit(5)
 // @Testing(preIdentityVersion="6.9.0")
 // @Testing(existsType="github.com/hashicorp/terraform-provider-aws/internal/service/lambda;tflambda;tflambda.PolicyStatement")
 // @Testing(importStateIdFunc="testAccPermissionImportStateIDFunc")
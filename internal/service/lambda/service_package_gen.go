@@ -246,10 +246,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Region:   inttypes.ResourceRegionDefault(),
 		},
 		{
-			Factory:  resourcePermission,
-			TypeName: "aws_lambda_permission",
-			Name:     "Permission",
-			Region:   inttypes.ResourceRegionDefault(),
+			Factory:          resourcePermission,
+			TypeName:         "aws_lambda_permission",
+			Name:             "Permission",
+			Region:           inttypes.ResourceRegionDefault(),
+			ConcurrencyLimit: 5,
 			Identity: inttypes.RegionalParameterizedIdentity([]inttypes.IdentityAttribute{
 				inttypes.StringIdentityAttribute("function_name", true),
 				inttypes.StringIdentityAttribute("statement_id", true),
@@ -22,4 +22,15 @@ var (
 	FindPolicyByID                         = findPolicyByID
 	FindResourcePolicy                     = findResourcePolicy
 	FindTag                                = findTag
+
+	ExpandBackupPolicyDocument            = expandBackupPolicyDocument
+	ValidatePolicyDocumentSize            = validatePolicyDocumentSize
+	ValidateResourceControlPolicyDocument = validateResourceControlPolicyDocument
+	ValidateServiceControlPolicyDocument  = validateServiceControlPolicyDocument
+)
+
+type (
+	PolicyDocumentBackupPlanModel      = policyDocumentBackupPlanModel
+	PolicyDocumentBackupRuleModel      = policyDocumentBackupRuleModel
+	PolicyDocumentBackupSelectionModel = policyDocumentBackupSelectionModel
 )
@@ -0,0 +1,794 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	policyDocumentVersion = "2012-10-17"
+
+	policyEffectAllow = "Allow"
+	policyEffectDeny  = "Deny"
+
+	// Management policies (tag, backup and AI services opt-out policies) use inheritance operators.
+	// https://docs.aws.amazon.com/organizations/latest/userguide/policy-operators.html.
+	policyOperatorAssign = "@@assign"
+)
+
+// policyDocumentSizeLimits are the maximum sizes, in characters, of policy documents by policy type.
+// https://docs.aws.amazon.com/organizations/latest/userguide/orgs_reference_limits.html#min-max-values.
+var policyDocumentSizeLimits = map[awstypes.PolicyType]int{
+	awstypes.PolicyTypeAiservicesOptOutPolicy: 2500,
+	awstypes.PolicyTypeBackupPolicy:           10000,
+	awstypes.PolicyTypeResourceControlPolicy:  5120,
+	awstypes.PolicyTypeServiceControlPolicy:   5120,
+	awstypes.PolicyTypeTagPolicy:              10000,
+}
+
+// resourceControlPolicyServices are the service prefixes of the actions that resource control policies support.
+// https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_rcps.html#rcp-supported-services.
+var resourceControlPolicyServices = []string{
+	"aoss",
+	"cognito-identity",
+	"dynamodb",
+	"ecr",
+	"kms",
+	"logs",
+	"s3",
+	"secretsmanager",
+	"sqs",
+	"sts",
+}
+
+// @FrameworkDataSource("aws_organizations_policy_document", name="Policy Document")
+func newPolicyDocumentDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &policyDocumentDataSource{}, nil
+}
+
+type policyDocumentDataSource struct {
+	framework.DataSourceWithModel[policyDocumentDataSourceModel]
+}
+
+func (d *policyDocumentDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrJSON: schema.StringAttribute{
+				Computed: true,
+			},
+			"minified_json": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PolicyType](),
+				Required:   true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(
+						awstypes.PolicyTypeAiservicesOptOutPolicy,
+						awstypes.PolicyTypeBackupPolicy,
+						awstypes.PolicyTypeResourceControlPolicy,
+						awstypes.PolicyTypeServiceControlPolicy,
+						awstypes.PolicyTypeTagPolicy,
+					)...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"ai_services_opt_out": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[policyDocumentAIServicesOptOutModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"opt_out_policy": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("optIn", "optOut"),
+							},
+						},
+						"service": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"backup_plan": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[policyDocumentBackupPlanModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"regions": schema.SetAttribute{
+							CustomType: fwtypes.SetOfStringType,
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrRule: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[policyDocumentBackupRuleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"complete_backup_window_minutes": schema.Int64Attribute{
+										Optional: true,
+									},
+									"lifecycle_delete_after_days": schema.Int64Attribute{
+										Optional: true,
+									},
+									"lifecycle_move_to_cold_storage_after_days": schema.Int64Attribute{
+										Optional: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrScheduleExpression: schema.StringAttribute{
+										Optional: true,
+									},
+									"start_backup_window_minutes": schema.Int64Attribute{
+										Optional: true,
+									},
+									"target_backup_vault_name": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"selection": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[policyDocumentBackupSelectionModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrIAMRoleARN: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"tag_key": schema.StringAttribute{
+										Required: true,
+									},
+									"tag_values": schema.ListAttribute{
+										CustomType: fwtypes.ListOfStringType,
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"statement": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[policyDocumentStatementModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrActions: schema.SetAttribute{
+							CustomType: fwtypes.SetOfStringType,
+							Optional:   true,
+						},
+						"effect": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(policyEffectAllow, policyEffectDeny),
+							},
+						},
+						"not_actions": schema.SetAttribute{
+							CustomType: fwtypes.SetOfStringType,
+							Optional:   true,
+						},
+						"not_resources": schema.SetAttribute{
+							CustomType: fwtypes.SetOfStringType,
+							Optional:   true,
+						},
+						names.AttrResources: schema.SetAttribute{
+							CustomType: fwtypes.SetOfStringType,
+							Optional:   true,
+						},
+						"sid": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrCondition: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[policyDocumentStatementConditionModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"test": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrValues: schema.ListAttribute{
+										CustomType: fwtypes.ListOfStringType,
+										Required:   true,
+									},
+									"variable": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"principals": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[policyDocumentStatementPrincipalModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"identifiers": schema.SetAttribute{
+										CustomType: fwtypes.SetOfStringType,
+										Required:   true,
+									},
+									names.AttrType: schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tag": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[policyDocumentTagModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"enforced_for": schema.SetAttribute{
+							CustomType: fwtypes.SetOfStringType,
+							Optional:   true,
+						},
+						names.AttrKey: schema.StringAttribute{
+							Required: true,
+						},
+						"report_required_tag_for": schema.SetAttribute{
+							CustomType: fwtypes.SetOfStringType,
+							Optional:   true,
+						},
+						names.AttrValues: schema.ListAttribute{
+							CustomType: fwtypes.ListOfStringType,
+							Optional:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *policyDocumentDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data policyDocumentDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policyType := data.Type.ValueEnum()
+
+	// Each policy type has its own grammar, modeled by its own block.
+	blocks := map[awstypes.PolicyType]string{
+		awstypes.PolicyTypeAiservicesOptOutPolicy: "ai_services_opt_out",
+		awstypes.PolicyTypeBackupPolicy:           "backup_plan",
+		awstypes.PolicyTypeResourceControlPolicy:  "statement",
+		awstypes.PolicyTypeServiceControlPolicy:   "statement",
+		awstypes.PolicyTypeTagPolicy:              "tag",
+	}
+	configured := map[string]bool{
+		"ai_services_opt_out": len(data.AIServicesOptOut.Elements()) > 0,
+		"backup_plan":         len(data.BackupPlan.Elements()) > 0,
+		"statement":           len(data.Statement.Elements()) > 0,
+		"tag":                 len(data.Tag.Elements()) > 0,
+	}
+	for block, ok := range configured {
+		if ok && block != blocks[policyType] {
+			response.Diagnostics.AddAttributeError(path.Root(block), "Invalid Policy Document", fmt.Sprintf("%s blocks are not supported in %s policy documents", block, policyType))
+		}
+	}
+	if !configured[blocks[policyType]] {
+		response.Diagnostics.AddAttributeError(path.Root(blocks[policyType]), "Invalid Policy Document", fmt.Sprintf("%s policy documents require at least one %s block", policyType, blocks[policyType]))
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var doc any
+	var err error
+	switch policyType {
+	case awstypes.PolicyTypeAiservicesOptOutPolicy:
+		var diags diag.Diagnostics
+		doc, diags = expandAIServicesOptOutPolicyDocument(ctx, data.AIServicesOptOut)
+		response.Diagnostics.Append(diags...)
+	case awstypes.PolicyTypeBackupPolicy:
+		var diags diag.Diagnostics
+		doc, diags = expandBackupPolicyDocument(ctx, data.BackupPlan)
+		response.Diagnostics.Append(diags...)
+	case awstypes.PolicyTypeResourceControlPolicy, awstypes.PolicyTypeServiceControlPolicy:
+		var iamDoc *tfiam.IAMPolicyDoc
+		var diags diag.Diagnostics
+		iamDoc, diags = expandControlPolicyDocument(ctx, policyType, data.Statement)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		if policyType == awstypes.PolicyTypeServiceControlPolicy {
+			err = validateServiceControlPolicyDocument(iamDoc)
+		} else {
+			err = validateResourceControlPolicyDocument(iamDoc)
+		}
+		doc = iamDoc
+	case awstypes.PolicyTypeTagPolicy:
+		var diags diag.Diagnostics
+		doc, diags = expandTagPolicyDocument(ctx, data.Tag)
+		response.Diagnostics.Append(diags...)
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root(blocks[policyType]), "Invalid Policy Document", err.Error())
+		return
+	}
+
+	jsonDoc, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		response.Diagnostics.AddError("writing Organizations Policy Document: formatting JSON", err.Error())
+		return
+	}
+	minifiedJSONDoc, err := json.Marshal(doc)
+	if err != nil {
+		response.Diagnostics.AddError("writing Organizations Policy Document: formatting JSON", err.Error())
+		return
+	}
+
+	if err := validatePolicyDocumentSize(policyType, string(minifiedJSONDoc)); err != nil {
+		response.Diagnostics.AddError("Policy Document Too Large", err.Error())
+		return
+	}
+
+	data.JSON = fwflex.StringValueToFramework(ctx, string(jsonDoc))
+	data.MinifiedJSON = fwflex.StringValueToFramework(ctx, string(minifiedJSONDoc))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func expandControlPolicyDocument(ctx context.Context, policyType awstypes.PolicyType, tfList fwtypes.ListNestedObjectValueOf[policyDocumentStatementModel]) (*tfiam.IAMPolicyDoc, diag.Diagnostics) { // nosemgrep:ci.semgrep.framework.manual-expander-functions
+	var diags diag.Diagnostics
+
+	statements, d := tfList.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := &tfiam.IAMPolicyDoc{
+		Version: policyDocumentVersion,
+	}
+
+	for _, statement := range statements {
+		apiObject := &tfiam.IAMPolicyStatement{
+			Sid:    statement.Sid.ValueString(),
+			Effect: statement.Effect.ValueString(),
+		}
+
+		if apiObject.Effect == "" {
+			// Resource control policies only support Deny statements.
+			apiObject.Effect = policyEffectAllow
+			if policyType == awstypes.PolicyTypeResourceControlPolicy {
+				apiObject.Effect = policyEffectDeny
+			}
+		}
+
+		if v := policyDocumentSortedStrings(ctx, statement.Actions); len(v) > 0 {
+			apiObject.Actions = policyDocumentStringOrSlice(v)
+		}
+		if v := policyDocumentSortedStrings(ctx, statement.NotActions); len(v) > 0 {
+			apiObject.NotActions = policyDocumentStringOrSlice(v)
+		}
+		if v := policyDocumentSortedStrings(ctx, statement.Resources); len(v) > 0 {
+			apiObject.Resources = policyDocumentStringOrSlice(v)
+		}
+		if v := policyDocumentSortedStrings(ctx, statement.NotResources); len(v) > 0 {
+			apiObject.NotResources = policyDocumentStringOrSlice(v)
+		}
+
+		principals, d := expandPolicyDocumentPrincipals(ctx, statement.Principals)
+		diags.Append(d...)
+		apiObject.Principals = principals
+
+		conditions, d := statement.Conditions.ToSlice(ctx)
+		diags.Append(d...)
+		for _, condition := range conditions {
+			apiObject.Conditions = append(apiObject.Conditions, tfiam.IAMPolicyStatementCondition{
+				Test:     condition.Test.ValueString(),
+				Variable: condition.Variable.ValueString(),
+				Values:   policyDocumentStringOrSlice(fwflex.ExpandFrameworkStringValueList(ctx, condition.Values)),
+			})
+		}
+
+		doc.Statements = append(doc.Statements, apiObject)
+	}
+
+	return doc, diags
+}
+
+func expandPolicyDocumentPrincipals(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[policyDocumentStatementPrincipalModel]) (tfiam.IAMPolicyStatementPrincipalSet, diag.Diagnostics) { // nosemgrep:ci.semgrep.framework.manual-expander-functions
+	principals, diags := tfList.ToSlice(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var apiObjects tfiam.IAMPolicyStatementPrincipalSet
+	for _, principal := range principals {
+		apiObjects = append(apiObjects, tfiam.IAMPolicyStatementPrincipal{
+			Type:        principal.Type.ValueString(),
+			Identifiers: policyDocumentStringOrSlice(policyDocumentSortedStrings(ctx, principal.Identifiers)),
+		})
+	}
+
+	return apiObjects, diags
+}
+
+func expandTagPolicyDocument(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[policyDocumentTagModel]) (map[string]any, diag.Diagnostics) { // nosemgrep:ci.semgrep.framework.manual-expander-functions
+	var diags diag.Diagnostics
+
+	tags, d := tfList.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tfMap := make(map[string]any, len(tags))
+	for _, tag := range tags {
+		key := tag.Key.ValueString()
+		// Tag policy keys are case-insensitive; tag_key specifies the capitalization to enforce.
+		policyKey := strings.ToLower(key)
+		if _, ok := tfMap[policyKey]; ok {
+			diags.AddAttributeError(path.Root("tag"), "Invalid Policy Document", fmt.Sprintf("duplicate tag key (%s); tag keys are case-insensitive", key))
+			continue
+		}
+
+		tagMap := map[string]any{
+			"tag_key": policyDocumentAssign(key),
+		}
+		if v := fwflex.ExpandFrameworkStringValueList(ctx, tag.Values); len(v) > 0 {
+			tagMap["tag_value"] = policyDocumentAssign(v)
+		}
+		if v := policyDocumentSortedStrings(ctx, tag.EnforcedFor); len(v) > 0 {
+			tagMap["enforced_for"] = policyDocumentAssign(v)
+		}
+		if v := policyDocumentSortedStrings(ctx, tag.ReportRequiredTagFor); len(v) > 0 {
+			tagMap["report_required_tag_for"] = policyDocumentAssign(v)
+		}
+
+		tfMap[policyKey] = tagMap
+	}
+
+	return map[string]any{
+		"tags": tfMap,
+	}, diags
+}
+
+func expandAIServicesOptOutPolicyDocument(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[policyDocumentAIServicesOptOutModel]) (map[string]any, diag.Diagnostics) { // nosemgrep:ci.semgrep.framework.manual-expander-functions
+	var diags diag.Diagnostics
+
+	services, d := tfList.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tfMap := make(map[string]any, len(services))
+	for _, service := range services {
+		name := service.Service.ValueString()
+		if _, ok := tfMap[name]; ok {
+			diags.AddAttributeError(path.Root("ai_services_opt_out"), "Invalid Policy Document", fmt.Sprintf("duplicate service (%s)", name))
+			continue
+		}
+
+		tfMap[name] = map[string]any{
+			"opt_out_policy": policyDocumentAssign(service.OptOutPolicy.ValueString()),
+		}
+	}
+
+	return map[string]any{
+		"services": tfMap,
+	}, diags
+}
+
+func expandBackupPolicyDocument(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[policyDocumentBackupPlanModel]) (map[string]any, diag.Diagnostics) { // nosemgrep:ci.semgrep.framework.manual-expander-functions
+	var diags diag.Diagnostics
+
+	plans, d := tfList.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Backup policies express numbers as strings.
+	assignInt64 := func(v types.Int64) map[string]any {
+		return policyDocumentAssign(strconv.FormatInt(v.ValueInt64(), 10))
+	}
+
+	plansMap := make(map[string]any, len(plans))
+	for i, plan := range plans {
+		planPath := path.Root("backup_plan").AtListIndex(i)
+		planName := plan.Name.ValueString()
+		if _, ok := plansMap[planName]; ok {
+			diags.AddAttributeError(planPath.AtName(names.AttrName), "Invalid Policy Document", fmt.Sprintf("duplicate backup plan name (%s)", planName))
+			continue
+		}
+
+		rules, d := plan.Rules.ToSlice(ctx)
+		diags.Append(d...)
+		selections, d := plan.Selections.ToSlice(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		rulesMap := make(map[string]any, len(rules))
+		for j, rule := range rules {
+			ruleName := rule.Name.ValueString()
+			if _, ok := rulesMap[ruleName]; ok {
+				diags.AddAttributeError(planPath.AtName("rule").AtListIndex(j).AtName(names.AttrName), "Invalid Policy Document", fmt.Sprintf("duplicate backup rule name (%s) in backup plan (%s)", ruleName, planName))
+				continue
+			}
+
+			ruleMap := map[string]any{
+				"target_backup_vault_name": policyDocumentAssign(rule.TargetBackupVaultName.ValueString()),
+			}
+			if !rule.ScheduleExpression.IsNull() {
+				ruleMap["schedule_expression"] = policyDocumentAssign(rule.ScheduleExpression.ValueString())
+			}
+			if !rule.StartBackupWindowMinutes.IsNull() {
+				ruleMap["start_backup_window_minutes"] = assignInt64(rule.StartBackupWindowMinutes)
+			}
+			if !rule.CompleteBackupWindowMinutes.IsNull() {
+				ruleMap["complete_backup_window_minutes"] = assignInt64(rule.CompleteBackupWindowMinutes)
+			}
+			lifecycleMap := make(map[string]any)
+			if !rule.LifecycleDeleteAfterDays.IsNull() {
+				lifecycleMap["delete_after_days"] = assignInt64(rule.LifecycleDeleteAfterDays)
+			}
+			if !rule.LifecycleMoveToColdStorageAfterDays.IsNull() {
+				lifecycleMap["move_to_cold_storage_after_days"] = assignInt64(rule.LifecycleMoveToColdStorageAfterDays)
+			}
+			if len(lifecycleMap) > 0 {
+				ruleMap["lifecycle"] = lifecycleMap
+			}
+
+			rulesMap[ruleName] = ruleMap
+		}
+
+		planMap := map[string]any{
+			"regions": policyDocumentAssign(policyDocumentSortedStrings(ctx, plan.Regions)),
+			"rules":   rulesMap,
+		}
+
+		if len(selections) > 0 {
+			tagsMap := make(map[string]any, len(selections))
+			for j, selection := range selections {
+				selectionName := selection.Name.ValueString()
+				if _, ok := tagsMap[selectionName]; ok {
+					diags.AddAttributeError(planPath.AtName("selection").AtListIndex(j).AtName(names.AttrName), "Invalid Policy Document", fmt.Sprintf("duplicate backup selection name (%s) in backup plan (%s)", selectionName, planName))
+					continue
+				}
+
+				tagsMap[selectionName] = map[string]any{
+					"iam_role_arn": policyDocumentAssign(selection.IAMRoleARN.ValueString()),
+					"tag_key":      policyDocumentAssign(selection.TagKey.ValueString()),
+					"tag_value":    policyDocumentAssign(fwflex.ExpandFrameworkStringValueList(ctx, selection.TagValues)),
+				}
+			}
+			planMap["selections"] = map[string]any{
+				"tags": tagsMap,
+			}
+		}
+
+		plansMap[planName] = planMap
+	}
+
+	return map[string]any{
+		"plans": plansMap,
+	}, diags
+}
+
+// validateServiceControlPolicyDocument returns an error if the policy document does not follow SCP syntax.
+// https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_scps_syntax.html.
+func validateServiceControlPolicyDocument(doc *tfiam.IAMPolicyDoc) error {
+	var errs []error
+
+	for i, statement := range doc.Statements {
+		if len(statement.Principals) > 0 {
+			errs = append(errs, fmt.Errorf("statement %d: principals are not supported in service control policies", i))
+		}
+	}
+
+	return errors.Join(append(errs, validateControlPolicyStatements(doc))...)
+}
+
+// validateResourceControlPolicyDocument returns an error if the policy document does not follow RCP syntax.
+// https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_rcps_syntax.html.
+func validateResourceControlPolicyDocument(doc *tfiam.IAMPolicyDoc) error {
+	var errs []error
+
+	for i, statement := range doc.Statements {
+		if statement.Effect != policyEffectDeny {
+			errs = append(errs, fmt.Errorf("statement %d: resource control policies only support the Deny effect", i))
+		}
+
+		if statement.NotActions != nil {
+			errs = append(errs, fmt.Errorf("statement %d: not_actions is not supported in resource control policies", i))
+		}
+		for _, action := range policyDocumentStrings(statement.Actions) {
+			if action == "*" {
+				continue
+			}
+			if service, _, _ := strings.Cut(action, ":"); !slices.Contains(resourceControlPolicyServices, strings.ToLower(service)) {
+				errs = append(errs, fmt.Errorf("statement %d: action (%s) is not supported in resource control policies; supported services are %s", i, action, strings.Join(resourceControlPolicyServices, ", ")))
+			}
+		}
+
+		switch principals := statement.Principals; {
+		case len(principals) == 0:
+			errs = append(errs, fmt.Errorf("statement %d: resource control policies require principals; use type \"*\" with identifiers [\"*\"] and narrow the principals with conditions", i))
+		case len(principals) > 1 || principals[0].Type != "*" || !slices.Equal(policyDocumentStrings(principals[0].Identifiers), []string{"*"}):
+			errs = append(errs, fmt.Errorf("statement %d: resource control policies only support the \"*\" principal; narrow the principals with conditions", i))
+		}
+	}
+
+	return errors.Join(append(errs, validateControlPolicyStatements(doc))...)
+}
+
+// validateControlPolicyStatements returns an error for statement rules common to SCPs and RCPs.
+func validateControlPolicyStatements(doc *tfiam.IAMPolicyDoc) error {
+	var errs []error
+	sids := make(map[string]struct{})
+
+	for i, statement := range doc.Statements {
+		if sid := statement.Sid; sid != "" {
+			if _, ok := sids[sid]; ok {
+				errs = append(errs, fmt.Errorf("statement %d: duplicate sid (%s)", i, sid))
+			}
+			sids[sid] = struct{}{}
+		}
+
+		if (statement.Actions == nil) == (statement.NotActions == nil) {
+			errs = append(errs, fmt.Errorf("statement %d: exactly one of actions or not_actions must be specified", i))
+		}
+		if statement.Resources != nil && statement.NotResources != nil {
+			errs = append(errs, fmt.Errorf("statement %d: only one of resources or not_resources can be specified", i))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validatePolicyDocumentSize returns an error if the minified policy document exceeds the size limit for its type.
+func validatePolicyDocumentSize(policyType awstypes.PolicyType, minifiedJSON string) error {
+	limit, ok := policyDocumentSizeLimits[policyType]
+	if !ok {
+		return nil
+	}
+
+	if size := len(minifiedJSON); size > limit {
+		return fmt.Errorf("minified %s document is %d characters, which exceeds the %d character limit by %d characters", policyType, size, limit, size-limit)
+	}
+
+	return nil
+}
+
+func policyDocumentAssign(v any) map[string]any {
+	return map[string]any{
+		policyOperatorAssign: v,
+	}
+}
+
+// policyDocumentStringOrSlice returns the single element of a single-element slice, or the slice itself.
+func policyDocumentStringOrSlice(s []string) any {
+	if len(s) == 1 {
+		return s[0]
+	}
+
+	return s
+}
+
+// policyDocumentSortedStrings returns the elements of a set of strings in a stable order.
+func policyDocumentSortedStrings(ctx context.Context, v fwtypes.SetOfString) []string {
+	return slices.Sorted(slices.Values(fwflex.ExpandFrameworkStringValueSet(ctx, v)))
+}
+
+func policyDocumentStrings(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	default:
+		return nil
+	}
+}
+
+type policyDocumentDataSourceModel struct {
+	AIServicesOptOut fwtypes.ListNestedObjectValueOf[policyDocumentAIServicesOptOutModel] `tfsdk:"ai_services_opt_out"`
+	BackupPlan       fwtypes.ListNestedObjectValueOf[policyDocumentBackupPlanModel]       `tfsdk:"backup_plan"`
+	JSON             types.String                                                         `tfsdk:"json"`
+	MinifiedJSON     types.String                                                         `tfsdk:"minified_json"`
+	Statement        fwtypes.ListNestedObjectValueOf[policyDocumentStatementModel]        `tfsdk:"statement"`
+	Tag              fwtypes.ListNestedObjectValueOf[policyDocumentTagModel]              `tfsdk:"tag"`
+	Type             fwtypes.StringEnum[awstypes.PolicyType]                              `tfsdk:"type"`
+}
+
+type policyDocumentAIServicesOptOutModel struct {
+	OptOutPolicy types.String `tfsdk:"opt_out_policy"`
+	Service      types.String `tfsdk:"service"`
+}
+
+type policyDocumentBackupPlanModel struct {
+	Name       types.String                                                        `tfsdk:"name"`
+	Regions    fwtypes.SetOfString                                                 `tfsdk:"regions"`
+	Rules      fwtypes.ListNestedObjectValueOf[policyDocumentBackupRuleModel]      `tfsdk:"rule"`
+	Selections fwtypes.ListNestedObjectValueOf[policyDocumentBackupSelectionModel] `tfsdk:"selection"`
+}
+
+type policyDocumentBackupRuleModel struct {
+	CompleteBackupWindowMinutes         types.Int64  `tfsdk:"complete_backup_window_minutes"`
+	LifecycleDeleteAfterDays            types.Int64  `tfsdk:"lifecycle_delete_after_days"`
+	LifecycleMoveToColdStorageAfterDays types.Int64  `tfsdk:"lifecycle_move_to_cold_storage_after_days"`
+	Name                                types.String `tfsdk:"name"`
+	ScheduleExpression                  types.String `tfsdk:"schedule_expression"`
+	StartBackupWindowMinutes            types.Int64  `tfsdk:"start_backup_window_minutes"`
+	TargetBackupVaultName               types.String `tfsdk:"target_backup_vault_name"`
+}
+
+type policyDocumentBackupSelectionModel struct {
+	IAMRoleARN types.String         `tfsdk:"iam_role_arn"`
+	Name       types.String         `tfsdk:"name"`
+	TagKey     types.String         `tfsdk:"tag_key"`
+	TagValues  fwtypes.ListOfString `tfsdk:"tag_values"`
+}
+
+type policyDocumentStatementModel struct {
+	Actions      fwtypes.SetOfString                                                    `tfsdk:"actions"`
+	Conditions   fwtypes.ListNestedObjectValueOf[policyDocumentStatementConditionModel] `tfsdk:"condition"`
+	Effect       types.String                                                           `tfsdk:"effect"`
+	NotActions   fwtypes.SetOfString                                                    `tfsdk:"not_actions"`
+	NotResources fwtypes.SetOfString                                                    `tfsdk:"not_resources"`
+	Principals   fwtypes.ListNestedObjectValueOf[policyDocumentStatementPrincipalModel] `tfsdk:"principals"`
+	Resources    fwtypes.SetOfString                                                    `tfsdk:"resources"`
+	Sid          types.String                                                           `tfsdk:"sid"`
+}
+
+type policyDocumentStatementConditionModel struct {
+	Test     types.String         `tfsdk:"test"`
+	Values   fwtypes.ListOfString `tfsdk:"values"`
+	Variable types.String         `tfsdk:"variable"`
+}
+
+type policyDocumentStatementPrincipalModel struct {
+	Identifiers fwtypes.SetOfString `tfsdk:"identifiers"`
+	Type        types.String        `tfsdk:"type"`
+}
+
+type policyDocumentTagModel struct {
+	EnforcedFor          fwtypes.SetOfString  `tfsdk:"enforced_for"`
+	Key                  types.String         `tfsdk:"key"`
+	ReportRequiredTagFor fwtypes.SetOfString  `tfsdk:"report_required_tag_for"`
+	Values               fwtypes.ListOfString `tfsdk:"values"`
+}
@@ -0,0 +1,580 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidateServiceControlPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		doc         *tfiam.IAMPolicyDoc
+		expectError bool
+	}{
+		"valid": {
+			doc: &tfiam.IAMPolicyDoc{
+				Statements: []*tfiam.IAMPolicyStatement{
+					{Sid: "DenyLeave", Effect: "Deny", Actions: "organizations:LeaveOrganization", Resources: "*"},
+					{Effect: "Allow", Actions: "*", Resources: "*"},
+				},
+			},
+		},
+		"principals": {
+			doc: &tfiam.IAMPolicyDoc{
+				Statements: []*tfiam.IAMPolicyStatement{
+					{Effect: "Deny", Actions: "s3:*", Resources: "*", Principals: tfiam.IAMPolicyStatementPrincipalSet{{Type: "*", Identifiers: "*"}}},
+				},
+			},
+			expectError: true,
+		},
+		"actions and not_actions": {
+			doc: &tfiam.IAMPolicyDoc{
+				Statements: []*tfiam.IAMPolicyStatement{
+					{Effect: "Deny", Actions: "s3:*", NotActions: "iam:*", Resources: "*"},
+				},
+			},
+			expectError: true,
+		},
+		"no actions": {
+			doc: &tfiam.IAMPolicyDoc{
+				Statements: []*tfiam.IAMPolicyStatement{
+					{Effect: "Deny", Resources: "*"},
+				},
+			},
+			expectError: true,
+		},
+		"duplicate sid": {
+			doc: &tfiam.IAMPolicyDoc{
+				Statements: []*tfiam.IAMPolicyStatement{
+					{Sid: "One", Effect: "Deny", Actions: "s3:*", Resources: "*"},
+					{Sid: "One", Effect: "Deny", Actions: "ec2:*", Resources: "*"},
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tforganizations.ValidateServiceControlPolicyDocument(testCase.doc)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ValidateServiceControlPolicyDocument() error = %v, expectError %t", err, want)
+			}
+		})
+	}
+}
+
+func TestValidateResourceControlPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	anyPrincipal := tfiam.IAMPolicyStatementPrincipalSet{{Type: "*", Identifiers: "*"}}
+
+	testCases := map[string]struct {
+		doc         *tfiam.IAMPolicyDoc
+		expectError bool
+	}{
+		"valid": {
+			doc: &tfiam.IAMPolicyDoc{
+				Statements: []*tfiam.IAMPolicyStatement{
+					{Effect: "Deny", Actions: []string{"s3:*", "sts:AssumeRole"}, Resources: "*", Principals: anyPrincipal},
+				},
+			},
+		},
+		"all actions": {
+			doc: &tfiam.IAMPolicyDoc{
+				Statements: []*tfiam.IAMPolicyStatement{
+					{Effect: "Deny", Actions: "*", Resources: "*", Principals: anyPrincipal},
+				},
+			},
+		},
+		"allow": {
+			doc: &tfiam.IAMPolicyDoc{
+				Statements: []*tfiam.IAMPolicyStatement{
+					{Effect: "Allow", Actions: "s3:*", Resources: "*", Principals: anyPrincipal},
+				},
+			},
+			expectError: true,
+		},
+		"unsupported service": {
+			doc: &tfiam.IAMPolicyDoc{
+				Statements: []*tfiam.IAMPolicyStatement{
+					{Effect: "Deny", Actions: "ec2:*", Resources: "*", Principals: anyPrincipal},
+				},
+			},
+			expectError: true,
+		},
+		"not_actions": {
+			doc: &tfiam.IAMPolicyDoc{
+				Statements: []*tfiam.IAMPolicyStatement{
+					{Effect: "Deny", NotActions: "s3:GetObject", Resources: "*", Principals: anyPrincipal},
+				},
+			},
+			expectError: true,
+		},
+		"no principals": {
+			doc: &tfiam.IAMPolicyDoc{
+				Statements: []*tfiam.IAMPolicyStatement{
+					{Effect: "Deny", Actions: "s3:*", Resources: "*"},
+				},
+			},
+			expectError: true,
+		},
+		"specific principal": {
+			doc: &tfiam.IAMPolicyDoc{
+				Statements: []*tfiam.IAMPolicyStatement{
+					{Effect: "Deny", Actions: "s3:*", Resources: "*", Principals: tfiam.IAMPolicyStatementPrincipalSet{{Type: "AWS", Identifiers: "123456789012"}}},
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tforganizations.ValidateResourceControlPolicyDocument(testCase.doc)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ValidateResourceControlPolicyDocument() error = %v, expectError %t", err, want)
+			}
+		})
+	}
+}
+
+func TestValidatePolicyDocumentSize(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policyType  awstypes.PolicyType
+		size        int
+		expectError bool
+	}{
+		"SCP at limit": {
+			policyType: awstypes.PolicyTypeServiceControlPolicy,
+			size:       5120,
+		},
+		"SCP over limit": {
+			policyType:  awstypes.PolicyTypeServiceControlPolicy,
+			size:        5121,
+			expectError: true,
+		},
+		"RCP over limit": {
+			policyType:  awstypes.PolicyTypeResourceControlPolicy,
+			size:        5121,
+			expectError: true,
+		},
+		"tag policy at limit": {
+			policyType: awstypes.PolicyTypeTagPolicy,
+			size:       10000,
+		},
+		"tag policy over limit": {
+			policyType:  awstypes.PolicyTypeTagPolicy,
+			size:        10001,
+			expectError: true,
+		},
+		"AI services opt-out policy over limit": {
+			policyType:  awstypes.PolicyTypeAiservicesOptOutPolicy,
+			size:        2501,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tforganizations.ValidatePolicyDocumentSize(testCase.policyType, strings.Repeat("x", testCase.size))
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ValidatePolicyDocumentSize() error = %v, expectError %t", err, want)
+			}
+		})
+	}
+}
+
+func TestExpandBackupPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	regions := fwtypes.NewSetValueOfMust[types.String](ctx, []attr.Value{types.StringValue("us-east-1")})
+	rule := func(name string) tforganizations.PolicyDocumentBackupRuleModel {
+		return tforganizations.PolicyDocumentBackupRuleModel{
+			Name:                  types.StringValue(name),
+			TargetBackupVaultName: types.StringValue("Default"),
+		}
+	}
+	selection := func(name string) tforganizations.PolicyDocumentBackupSelectionModel {
+		return tforganizations.PolicyDocumentBackupSelectionModel{
+			IAMRoleARN: types.StringValue("arn:aws:iam::$account:role/Backup"),
+			Name:       types.StringValue(name),
+			TagKey:     types.StringValue("backup"),
+			TagValues:  fwtypes.NewListValueOfMust[types.String](ctx, []attr.Value{types.StringValue("daily")}),
+		}
+	}
+	plan := func(name string, rules []tforganizations.PolicyDocumentBackupRuleModel, selections []tforganizations.PolicyDocumentBackupSelectionModel) tforganizations.PolicyDocumentBackupPlanModel {
+		return tforganizations.PolicyDocumentBackupPlanModel{
+			Name:       types.StringValue(name),
+			Regions:    regions,
+			Rules:      fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, rules),
+			Selections: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, selections),
+		}
+	}
+
+	testCases := map[string]struct {
+		plans         []tforganizations.PolicyDocumentBackupPlanModel
+		expectedError string
+	}{
+		"valid": {
+			plans: []tforganizations.PolicyDocumentBackupPlanModel{
+				plan("daily", []tforganizations.PolicyDocumentBackupRuleModel{rule("daily"), rule("weekly")}, []tforganizations.PolicyDocumentBackupSelectionModel{selection("daily")}),
+				plan("weekly", []tforganizations.PolicyDocumentBackupRuleModel{rule("weekly")}, nil),
+			},
+		},
+		"duplicate plan name": {
+			plans: []tforganizations.PolicyDocumentBackupPlanModel{
+				plan("daily", []tforganizations.PolicyDocumentBackupRuleModel{rule("daily")}, nil),
+				plan("daily", []tforganizations.PolicyDocumentBackupRuleModel{rule("weekly")}, nil),
+			},
+			expectedError: "duplicate backup plan name (daily)",
+		},
+		"duplicate rule name": {
+			plans: []tforganizations.PolicyDocumentBackupPlanModel{
+				plan("daily", []tforganizations.PolicyDocumentBackupRuleModel{rule("daily"), rule("daily")}, nil),
+			},
+			expectedError: "duplicate backup rule name (daily) in backup plan (daily)",
+		},
+		"duplicate selection name": {
+			plans: []tforganizations.PolicyDocumentBackupPlanModel{
+				plan("daily", []tforganizations.PolicyDocumentBackupRuleModel{rule("daily")}, []tforganizations.PolicyDocumentBackupSelectionModel{selection("tagged"), selection("tagged")}),
+			},
+			expectedError: "duplicate backup selection name (tagged) in backup plan (daily)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, diags := tforganizations.ExpandBackupPolicyDocument(ctx, fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, testCase.plans))
+
+			if testCase.expectedError == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected error: %v", diags)
+				}
+				return
+			}
+
+			if got, want := diags.ErrorsCount(), 1; got != want {
+				t.Fatalf("got %d errors, want %d: %v", got, want, diags)
+			}
+			if got, want := diags.Errors()[0].Detail(), testCase.expectedError; got != want {
+				t.Errorf("got error %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAccOrganizationsPolicyDocumentDataSource_serviceControlPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_policy_document.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_serviceControlPolicy,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json", `{"Version":"2012-10-17","Statement":[{"Sid":"DenyLeaveOrganization","Effect":"Deny","Action":"organizations:LeaveOrganization","Resource":"*"},{"Effect":"Deny","Action":["ec2:RunInstances","ec2:StartInstances"],"Resource":"*","Condition":{"StringNotEquals":{"aws:RequestedRegion":["us-west-2","us-east-1"]}}}]}`),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsPolicyDocumentDataSource_resourceControlPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_policy_document.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_resourceControlPolicy,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json", `{"Version":"2012-10-17","Statement":[{"Sid":"EnforceSecureTransport","Effect":"Deny","Action":"s3:*","Resource":"*","Principal":"*","Condition":{"BoolIfExists":{"aws:SecureTransport":"false"}}}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsPolicyDocumentDataSource_resourceControlPolicyUnsupportedService(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_resourceControlPolicyUnsupportedService,
+				ExpectError: regexache.MustCompile(`action \(ec2:RunInstances\) is not supported in resource control\s+policies`),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsPolicyDocumentDataSource_tagPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_policy_document.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_tagPolicy,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json", `{"tags":{"costcenter":{"enforced_for":{"@@assign":["ec2:instance","s3:bucket"]},"tag_key":{"@@assign":"CostCenter"},"tag_value":{"@@assign":["100","200"]}}}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsPolicyDocumentDataSource_aiServicesOptOutPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_policy_document.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_aiServicesOptOutPolicy,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json", `{"services":{"default":{"opt_out_policy":{"@@assign":"optOut"}}}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsPolicyDocumentDataSource_backupPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_policy_document.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_backupPolicy,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json", `{"plans":{"daily":{"regions":{"@@assign":["us-east-1","us-west-2"]},"rules":{"daily":{"lifecycle":{"delete_after_days":{"@@assign":"35"}},"schedule_expression":{"@@assign":"cron(0 5 ? * * *)"},"target_backup_vault_name":{"@@assign":"Default"}}},"selections":{"tags":{"datatype":{"iam_role_arn":{"@@assign":"arn:aws:iam::$account:role/BackupRole"},"tag_key":{"@@assign":"dataType"},"tag_value":{"@@assign":["PII"]}}}}}}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsPolicyDocumentDataSource_blockTypeMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_blockTypeMismatch,
+				ExpectError: regexache.MustCompile(`tag blocks are not supported in SERVICE_CONTROL_POLICY policy\s+documents`),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsPolicyDocumentDataSource_sizeLimitExceeded(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_sizeLimitExceeded(300),
+				ExpectError: regexache.MustCompile(`Policy Document Too Large`),
+			},
+		},
+	})
+}
+
+const testAccPolicyDocumentDataSourceConfig_serviceControlPolicy = `
+data "aws_organizations_policy_document" "test" {
+  type = "SERVICE_CONTROL_POLICY"
+
+  statement {
+    sid       = "DenyLeaveOrganization"
+    effect    = "Deny"
+    actions   = ["organizations:LeaveOrganization"]
+    resources = ["*"]
+  }
+
+  statement {
+    effect    = "Deny"
+    actions   = ["ec2:RunInstances", "ec2:StartInstances"]
+    resources = ["*"]
+
+    condition {
+      test     = "StringNotEquals"
+      variable = "aws:RequestedRegion"
+      values   = ["us-west-2", "us-east-1"]
+    }
+  }
+}
+`
+
+const testAccPolicyDocumentDataSourceConfig_resourceControlPolicy = `
+data "aws_organizations_policy_document" "test" {
+  type = "RESOURCE_CONTROL_POLICY"
+
+  statement {
+    sid       = "EnforceSecureTransport"
+    actions   = ["s3:*"]
+    resources = ["*"]
+
+    principals {
+      type        = "*"
+      identifiers = ["*"]
+    }
+
+    condition {
+      test     = "BoolIfExists"
+      variable = "aws:SecureTransport"
+      values   = ["false"]
+    }
+  }
+}
+`
+
+const testAccPolicyDocumentDataSourceConfig_resourceControlPolicyUnsupportedService = `
+data "aws_organizations_policy_document" "test" {
+  type = "RESOURCE_CONTROL_POLICY"
+
+  statement {
+    actions   = ["ec2:RunInstances"]
+    resources = ["*"]
+
+    principals {
+      type        = "*"
+      identifiers = ["*"]
+    }
+  }
+}
+`
+
+const testAccPolicyDocumentDataSourceConfig_tagPolicy = `
+data "aws_organizations_policy_document" "test" {
+  type = "TAG_POLICY"
+
+  tag {
+    key          = "CostCenter"
+    values       = ["100", "200"]
+    enforced_for = ["s3:bucket", "ec2:instance"]
+  }
+}
+`
+
+const testAccPolicyDocumentDataSourceConfig_aiServicesOptOutPolicy = `
+data "aws_organizations_policy_document" "test" {
+  type = "AISERVICES_OPT_OUT_POLICY"
+
+  ai_services_opt_out {
+    service        = "default"
+    opt_out_policy = "optOut"
+  }
+}
+`
+
+const testAccPolicyDocumentDataSourceConfig_backupPolicy = `
+data "aws_organizations_policy_document" "test" {
+  type = "BACKUP_POLICY"
+
+  backup_plan {
+    name    = "daily"
+    regions = ["us-west-2", "us-east-1"]
+
+    rule {
+      name                        = "daily"
+      target_backup_vault_name    = "Default"
+      schedule_expression         = "cron(0 5 ? * * *)"
+      lifecycle_delete_after_days = 35
+    }
+
+    selection {
+      name         = "datatype"
+      iam_role_arn = "arn:aws:iam::$account:role/BackupRole"
+      tag_key      = "dataType"
+      tag_values   = ["PII"]
+    }
+  }
+}
+`
+
+const testAccPolicyDocumentDataSourceConfig_blockTypeMismatch = `
+data "aws_organizations_policy_document" "test" {
+  type = "SERVICE_CONTROL_POLICY"
+
+  statement {
+    actions   = ["*"]
+    resources = ["*"]
+  }
+
+  tag {
+    key = "CostCenter"
+  }
+}
+`
+
+func testAccPolicyDocumentDataSourceConfig_sizeLimitExceeded(n int) string {
+	return fmt.Sprintf(`
+data "aws_organizations_policy_document" "test" {
+  type = "SERVICE_CONTROL_POLICY"
+
+  statement {
+    effect    = "Deny"
+    actions   = [for i in range(%[1]d) : format("ec2:Action%%04d", i)]
+    resources = ["*"]
+  }
+}
+`, n)
+}
@@ -30,6 +30,12 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 			Name:     "Entity Path",
 			Region:   inttypes.ResourceRegionDisabled(),
 		},
+		{
+			Factory:  newPolicyDocumentDataSource,
+			TypeName: "aws_organizations_policy_document",
+			Name:     "Policy Document",
+			Region:   inttypes.ResourceRegionDisabled(),
+		},
 	}
 }
 
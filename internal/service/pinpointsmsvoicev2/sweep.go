@@ -17,12 +17,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_pinpointsmsvoicev2_phone_number", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_pinpointsmsvoicev2_phone_number", &resource.Sweeper{
 		Name:         "aws_pinpointsmsvoicev2_phone_number",
 		F:            sweepPhoneNumbers,
 		Dependencies: []string{"aws_pinpointsmsvoicev2_pool"},
 	})
-	resource.AddTestSweepers("aws_pinpointsmsvoicev2_pool", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_pinpointsmsvoicev2_pool", &resource.Sweeper{
 		Name: "aws_pinpointsmsvoicev2_pool",
 		F:    sweepPools,
 	})
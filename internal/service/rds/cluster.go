@@ -2119,6 +2119,71 @@ func waitDBClusterAvailable(ctx context.Context, conn *rds.Client, id string, wa
 	return nil, err
 }
 
+// statusDBClusterFailover returns the DB cluster's status during a failover.
+// An available DB cluster whose writer is still previousWriterID, or isn't targetWriterID if specified, has not yet failed over.
+func statusDBClusterFailover(conn *rds.Client, id, previousWriterID, targetWriterID string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findDBClusterByID(ctx, conn, id)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.ToString(output.Status)
+
+		if status == clusterStatusAvailable {
+			writerID := dbClusterWriterInstanceID(output)
+
+			if writerID == "" || writerID == previousWriterID || (targetWriterID != "" && writerID != targetWriterID) {
+				status = clusterStatusAvailableWithWriterUnchanged
+			}
+		}
+
+		return output, status, nil
+	}
+}
+
+// waitDBClusterFailedOver waits for a DB cluster whose writer was previousWriterID to fail over to targetWriterID,
+// or to any other DB instance if targetWriterID is empty, and to become available.
+func waitDBClusterFailedOver(ctx context.Context, conn *rds.Client, id, previousWriterID, targetWriterID string, timeout time.Duration) (*types.DBCluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			clusterStatusAvailableWithWriterUnchanged,
+			clusterStatusFailingOver,
+			clusterStatusModifying,
+			clusterStatusRebooting,
+		},
+		Target:     []string{clusterStatusAvailable},
+		Refresh:    statusDBClusterFailover(conn, id, previousWriterID, targetWriterID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DBCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// dbClusterWriterInstanceID returns the identifier of the DB cluster's writer instance, or "" if it has none.
+func dbClusterWriterInstanceID(dbCluster *types.DBCluster) string {
+	for _, v := range dbCluster.DBClusterMembers {
+		if aws.ToBool(v.IsClusterWriter) {
+			return aws.ToString(v.DBInstanceIdentifier)
+		}
+	}
+
+	return ""
+}
+
 func waitDBClusterCreated(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBCluster, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_rds_create_db_snapshot, name="Create DB Snapshot")
+func newCreateDBSnapshotAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &createDBSnapshotAction{}, nil
+}
+
+var (
+	_ action.Action = (*createDBSnapshotAction)(nil)
+)
+
+type createDBSnapshotAction struct {
+	framework.ActionWithModel[createDBSnapshotActionModel]
+}
+
+type createDBSnapshotActionModel struct {
+	framework.WithRegionModel
+	DBInstanceIdentifier types.String `tfsdk:"db_instance_identifier"`
+	DBSnapshotIdentifier types.String `tfsdk:"db_snapshot_identifier"`
+	Tags                 tftags.Map   `tfsdk:"tags"`
+	Timeout              types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *createDBSnapshotAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a manual snapshot of an RDS DB instance and waits for it to become available.",
+		Attributes: map[string]schema.Attribute{
+			"db_instance_identifier": schema.StringAttribute{
+				Description: "The identifier of the DB instance to snapshot",
+				Required:    true,
+			},
+			"db_snapshot_identifier": schema.StringAttribute{
+				Description: "The identifier for the DB snapshot. If not provided, an identifier will be generated using the DB instance identifier and a unique suffix",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+					stringvalidator.RegexMatches(
+						regexache.MustCompile(`^[A-Za-z][0-9A-Za-z-]*$`),
+						"must begin with a letter and contain only alphanumeric characters and hyphens",
+					),
+				},
+			},
+			names.AttrTags: tftags.TagsAttribute(),
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the snapshot to become available (default: 1200)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+	}
+}
+
+func (a *createDBSnapshotAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config createDBSnapshotActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	timeout := fwactions.TimeoutOr(config.Timeout, 20*time.Minute)
+
+	dbInstanceID := fwflex.StringValueFromFramework(ctx, config.DBInstanceIdentifier)
+	dbSnapshotID := fwflex.StringValueFromFramework(ctx, config.DBSnapshotIdentifier)
+
+	if dbSnapshotID == "" {
+		dbSnapshotID = fmt.Sprintf("%s-snapshot-%s", dbInstanceID, create.UniqueId(ctx))
+	}
+
+	tflog.Info(ctx, "Starting RDS create DB snapshot action", map[string]any{
+		"db_instance_identifier": dbInstanceID,
+		"db_snapshot_identifier": dbSnapshotID,
+		names.AttrTimeout:        timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Creating snapshot %s of RDS DB instance %s...", dbSnapshotID, dbInstanceID)
+
+	input := rds.CreateDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(dbInstanceID),
+		DBSnapshotIdentifier: aws.String(dbSnapshotID),
+	}
+
+	tags := a.Meta().DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, config.Tags))
+	if len(tags) > 0 {
+		input.Tags = svcTags(tags.IgnoreAWS())
+	}
+
+	if _, err := conn.CreateDBSnapshot(ctx, &input); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("creating RDS DB Snapshot (%s)", dbSnapshotID), err.Error())
+		return
+	}
+
+	cb(ctx, "Snapshot %s started, waiting for it to become available...", dbSnapshotID)
+
+	stop := fwactions.SendProgressEvery(ctx, cb, 30*time.Second, "Snapshot %s is still being created", dbSnapshotID)
+	output, err := waitDBSnapshotCreated(ctx, conn, dbSnapshotID, timeout)
+	stop()
+
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("waiting for RDS DB Snapshot (%s) create", dbSnapshotID), err.Error())
+		return
+	}
+
+	cb(ctx, "Snapshot completed successfully\n"+
+		"  ARN: %s\n"+
+		"  Created: %s\n"+
+		"  Allocated storage: %d GiB",
+		aws.ToString(output.DBSnapshotArn),
+		aws.ToTime(output.SnapshotCreateTime).Format(time.RFC3339),
+		aws.ToInt32(output.AllocatedStorage),
+	)
+
+	tflog.Info(ctx, "RDS create DB snapshot action completed successfully", map[string]any{
+		"db_instance_identifier": dbInstanceID,
+		"db_snapshot_arn":        aws.ToString(output.DBSnapshotArn),
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSCreateDBSnapshotAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	t.Cleanup(func() {
+		testAccDeleteDBSnapshot(ctx, t, rName)
+	})
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateDBSnapshotActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCreateDBSnapshotActionSnapshotAvailable(ctx, t, rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCreateDBSnapshotActionSnapshotAvailable(ctx context.Context, t *testing.T, id string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).RDSClient(ctx)
+
+		output, err := tfrds.FindDBSnapshotByID(ctx, conn, id)
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.ToString(output.Status), "available"; got != want {
+			return fmt.Errorf("RDS DB Snapshot (%s) status = %s, want %s", id, got, want)
+		}
+
+		for _, v := range output.TagList {
+			if aws.ToString(v.Key) == "Name" && aws.ToString(v.Value) == id {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("RDS DB Snapshot (%s) missing tag Name", id)
+	}
+}
+
+// testAccDeleteDBSnapshot deletes a DB snapshot created outside of Terraform's management, e.g. by an action.
+func testAccDeleteDBSnapshot(ctx context.Context, t *testing.T, id string) {
+	t.Helper()
+
+	conn := acctest.ProviderMeta(ctx, t).RDSClient(ctx)
+
+	if _, err := tfrds.FindDBSnapshotByID(ctx, conn, id); retry.NotFound(err) {
+		return
+	}
+
+	input := rds.DeleteDBSnapshotInput{
+		DBSnapshotIdentifier: aws.String(id),
+	}
+	if _, err := conn.DeleteDBSnapshot(ctx, &input); err != nil {
+		t.Errorf("deleting RDS DB Snapshot (%s): %s", id, err)
+	}
+}
+
+func testAccCreateDBSnapshotActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_basic(rName), fmt.Sprintf(`
+action "aws_rds_create_db_snapshot" "test" {
+  config {
+    db_instance_identifier = aws_db_instance.test.identifier
+    db_snapshot_identifier = %[1]q
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+}
+
+resource "terraform_data" "test" {
+  input = aws_db_instance.test.identifier
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.aws_rds_create_db_snapshot.test]
+    }
+  }
+}
+`, rName))
+}
@@ -35,6 +35,7 @@ var (
 	ResourceSnapshotCopy                        = resourceSnapshotCopy
 	ResourceSubnetGroup                         = resourceSubnetGroup
 
+	BuildDBAuthToken                           = buildDBAuthToken
 	ClusterIDAndRegionFromARN                  = clusterIDAndRegionFromARN
 	FindCustomDBEngineVersionByTwoPartKey      = findCustomDBEngineVersionByTwoPartKey
 	FindDBClusterByID                          = findDBClusterByID
@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_rds_failover_db_cluster, name="Failover DB Cluster")
+func newFailoverDBClusterAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &failoverDBClusterAction{}, nil
+}
+
+var (
+	_ action.Action = (*failoverDBClusterAction)(nil)
+)
+
+type failoverDBClusterAction struct {
+	framework.ActionWithModel[failoverDBClusterActionModel]
+}
+
+type failoverDBClusterActionModel struct {
+	framework.WithRegionModel
+	DBClusterIdentifier        types.String `tfsdk:"db_cluster_identifier"`
+	TargetDBInstanceIdentifier types.String `tfsdk:"target_db_instance_identifier"`
+	Timeout                    types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *failoverDBClusterAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forces a failover of an Aurora or Multi-AZ DB cluster, promoting a reader to be the writer, and waits for the cluster to become available with the new writer.",
+		Attributes: map[string]schema.Attribute{
+			"db_cluster_identifier": schema.StringAttribute{
+				Description: "The identifier of the DB cluster to fail over",
+				Required:    true,
+			},
+			"target_db_instance_identifier": schema.StringAttribute{
+				Description: "The identifier of the DB instance to promote to the writer. If not provided, RDS chooses a reader",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the DB cluster to fail over and become available (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+	}
+}
+
+func (a *failoverDBClusterAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config failoverDBClusterActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	timeout := fwactions.TimeoutOr(config.Timeout, 30*time.Minute)
+
+	dbClusterID := fwflex.StringValueFromFramework(ctx, config.DBClusterIdentifier)
+	targetDBInstanceID := fwflex.StringValueFromFramework(ctx, config.TargetDBInstanceIdentifier)
+
+	tflog.Info(ctx, "Starting RDS failover DB cluster action", map[string]any{
+		"db_cluster_identifier":         dbClusterID,
+		"target_db_instance_identifier": targetDBInstanceID,
+		names.AttrTimeout:               timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	if targetDBInstanceID != "" {
+		cb(ctx, "Failing over RDS DB cluster %s to DB instance %s...", dbClusterID, targetDBInstanceID)
+	} else {
+		cb(ctx, "Failing over RDS DB cluster %s...", dbClusterID)
+	}
+
+	dbCluster, err := findDBClusterByID(ctx, conn, dbClusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("reading RDS Cluster (%s)", dbClusterID), err.Error())
+		return
+	}
+
+	previousWriterID := dbClusterWriterInstanceID(dbCluster)
+	if targetDBInstanceID != "" && targetDBInstanceID == previousWriterID {
+		resp.Diagnostics.AddError(fmt.Sprintf("failing over RDS Cluster (%s)", dbClusterID), fmt.Sprintf("DB instance %s is already the writer", targetDBInstanceID))
+		return
+	}
+
+	input := rds.FailoverDBClusterInput{
+		DBClusterIdentifier:        aws.String(dbClusterID),
+		TargetDBInstanceIdentifier: fwflex.StringFromFramework(ctx, config.TargetDBInstanceIdentifier),
+	}
+
+	if _, err := conn.FailoverDBCluster(ctx, &input); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failing over RDS Cluster (%s)", dbClusterID), err.Error())
+		return
+	}
+
+	cb(ctx, "Failover started, waiting for DB cluster %s to become available with a new writer...", dbClusterID)
+
+	stop := fwactions.SendProgressEvery(ctx, cb, 30*time.Second, "DB cluster %s is still failing over", dbClusterID)
+	output, err := waitDBClusterFailedOver(ctx, conn, dbClusterID, previousWriterID, targetDBInstanceID, timeout)
+	stop()
+
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("waiting for RDS Cluster (%s) failover", dbClusterID), err.Error())
+		return
+	}
+
+	writerID := dbClusterWriterInstanceID(output)
+	cb(ctx, "DB cluster %s failed over successfully (writer: %s, previous writer: %s)", dbClusterID, writerID, previousWriterID)
+
+	tflog.Info(ctx, "RDS failover DB cluster action completed successfully", map[string]any{
+		"db_cluster_identifier": dbClusterID,
+		"previous_writer":       previousWriterID,
+		"writer":                writerID,
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSFailoverDBClusterAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFailoverDBClusterActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFailoverDBClusterActionWriter(ctx, t, rName, rName+"-2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFailoverDBClusterActionWriter(ctx context.Context, t *testing.T, clusterID, writerID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).RDSClient(ctx)
+
+		output, err := tfrds.FindDBClusterByID(ctx, conn, clusterID)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.DBClusterMembers {
+			if aws.ToBool(v.IsClusterWriter) {
+				if got := aws.ToString(v.DBInstanceIdentifier); got != writerID {
+					return fmt.Errorf("RDS Cluster (%s) writer = %s, want %s", clusterID, got, writerID)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("RDS Cluster (%s) has no writer", clusterID)
+	}
+}
+
+func testAccFailoverDBClusterActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterInstanceConfig_base(rName, "aurora-mysql"), fmt.Sprintf(`
+resource "aws_rds_cluster_instance" "test" {
+  identifier         = %[1]q
+  engine             = data.aws_rds_engine_version.default.engine
+  cluster_identifier = aws_rds_cluster.test.id
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+
+# Created after the first instance so that it joins the cluster as a reader.
+resource "aws_rds_cluster_instance" "test2" {
+  identifier         = "%[1]s-2"
+  engine             = data.aws_rds_engine_version.default.engine
+  cluster_identifier = aws_rds_cluster.test.id
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+
+  depends_on = [aws_rds_cluster_instance.test]
+}
+
+action "aws_rds_failover_db_cluster" "test" {
+  config {
+    db_cluster_identifier         = aws_rds_cluster.test.id
+    target_db_instance_identifier = aws_rds_cluster_instance.test2.identifier
+  }
+}
+
+resource "terraform_data" "test" {
+  input = aws_rds_cluster_instance.test2.identifier
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.aws_rds_failover_db_cluster.test]
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/authtoken"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// dbAuthTokenExpiresIn is the lifetime of an IAM database authentication token.
+	// See https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/UsingWithRDS.IAMDBAuth.html.
+	dbAuthTokenExpiresIn = 15 * time.Minute
+)
+
+// @EphemeralResource("aws_rds_generate_db_auth_token", name="Generate DB Auth Token")
+func newGenerateDBAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &generateDBAuthTokenEphemeralResource{}, nil
+}
+
+type generateDBAuthTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[generateDBAuthTokenEphemeralResourceModel]
+}
+
+func (e *generateDBAuthTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"expiration": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Computed:    true,
+				Description: "The expiration time of the token in RFC3339 format.",
+			},
+			"hostname": schema.StringAttribute{
+				Required:    true,
+				Description: "The hostname of the DB instance, Aurora cluster or RDS Proxy endpoint.",
+			},
+			names.AttrPort: schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65535),
+				},
+				Description: "The port of the database endpoint.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The authentication token, which is used as the database user's password.",
+			},
+			names.AttrUsername: schema.StringAttribute{
+				Required:    true,
+				Description: "The database user to authenticate as.",
+			},
+		},
+	}
+}
+
+func (e *generateDBAuthTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data generateDBAuthTokenEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := net.JoinHostPort(fwflex.StringValueFromFramework(ctx, data.Hostname), strconv.Itoa(int(data.Port.ValueInt32())))
+	now := time.Now().UTC()
+
+	token, err := buildDBAuthToken(ctx, endpoint, e.Meta().Region(ctx), fwflex.StringValueFromFramework(ctx, data.Username), e.Meta().AwsConfig(ctx).Credentials, now)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	data.Expiration = timetypes.NewRFC3339TimeValue(now.Add(dbAuthTokenExpiresIn))
+	data.Token = types.StringValue(token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type generateDBAuthTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Expiration timetypes.RFC3339 `tfsdk:"expiration"`
+	Hostname   types.String      `tfsdk:"hostname"`
+	Port       types.Int32       `tfsdk:"port"`
+	Token      types.String      `tfsdk:"token"`
+	Username   types.String      `tfsdk:"username"`
+}
+
+// buildDBAuthToken returns an IAM database authentication token for the specified endpoint (host:port), Region and database user.
+// The token is a SigV4 presigned URL, without the scheme, and is generated locally; no AWS API is called.
+// This is equivalent to github.com/aws/aws-sdk-go-v2/feature/rds/auth.BuildAuthToken.
+func buildDBAuthToken(ctx context.Context, endpoint, region, dbUser string, credentials aws.CredentialsProvider, signingTime time.Time) (string, error) {
+	query := url.Values{
+		"Action": []string{"connect"},
+		"DBUser": []string{dbUser},
+	}
+
+	return authtoken.Build(ctx, endpoint, "rds-db", region, query, dbAuthTokenExpiresIn, credentials, signingTime)
+}
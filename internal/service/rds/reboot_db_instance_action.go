@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_rds_reboot_db_instance, name="Reboot DB Instance")
+func newRebootDBInstanceAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &rebootDBInstanceAction{}, nil
+}
+
+var (
+	_ action.Action = (*rebootDBInstanceAction)(nil)
+)
+
+type rebootDBInstanceAction struct {
+	framework.ActionWithModel[rebootDBInstanceActionModel]
+}
+
+type rebootDBInstanceActionModel struct {
+	framework.WithRegionModel
+	DBInstanceIdentifier types.String `tfsdk:"db_instance_identifier"`
+	ForceFailover        types.Bool   `tfsdk:"force_failover"`
+	Timeout              types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *rebootDBInstanceAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reboots an RDS DB instance, optionally forcing a Multi-AZ failover, and waits for it to become available.",
+		Attributes: map[string]schema.Attribute{
+			"db_instance_identifier": schema.StringAttribute{
+				Description: "The identifier of the DB instance to reboot",
+				Required:    true,
+			},
+			"force_failover": schema.BoolAttribute{
+				Description: "Whether the reboot is conducted through a Multi-AZ failover. The DB instance must be configured for Multi-AZ",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the DB instance to become available (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+	}
+}
+
+func (a *rebootDBInstanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config rebootDBInstanceActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	timeout := fwactions.TimeoutOr(config.Timeout, 30*time.Minute)
+
+	dbInstanceID := fwflex.StringValueFromFramework(ctx, config.DBInstanceIdentifier)
+	forceFailover := config.ForceFailover.ValueBool()
+
+	tflog.Info(ctx, "Starting RDS reboot DB instance action", map[string]any{
+		"db_instance_identifier": dbInstanceID,
+		"force_failover":         forceFailover,
+		names.AttrTimeout:        timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	if forceFailover {
+		cb(ctx, "Rebooting RDS DB instance %s with failover...", dbInstanceID)
+	} else {
+		cb(ctx, "Rebooting RDS DB instance %s...", dbInstanceID)
+	}
+
+	input := rds.RebootDBInstanceInput{
+		DBInstanceIdentifier: aws.String(dbInstanceID),
+	}
+	if forceFailover {
+		input.ForceFailover = aws.Bool(true)
+	}
+
+	if _, err := conn.RebootDBInstance(ctx, &input); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("rebooting RDS DB Instance (%s)", dbInstanceID), err.Error())
+		return
+	}
+
+	cb(ctx, "Reboot started, waiting for DB instance %s to become available...", dbInstanceID)
+
+	stop := fwactions.SendProgressEvery(ctx, cb, 30*time.Second, "DB instance %s is still rebooting", dbInstanceID)
+	output, err := waitDBInstanceAvailable(ctx, conn, dbInstanceID, timeout)
+	stop()
+
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("waiting for RDS DB Instance (%s) reboot", dbInstanceID), err.Error())
+		return
+	}
+
+	cb(ctx, "DB instance %s rebooted successfully (Availability Zone: %s)", dbInstanceID, aws.ToString(output.AvailabilityZone))
+
+	tflog.Info(ctx, "RDS reboot DB instance action completed successfully", map[string]any{
+		"db_instance_identifier":   dbInstanceID,
+		names.AttrAvailabilityZone: aws.ToString(output.AvailabilityZone),
+	})
+}
@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSRebootDBInstanceAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRebootDBInstanceActionConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRebootDBInstanceActionAvailable(ctx, t, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSRebootDBInstanceAction_forceFailoverNotMultiAZ(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRebootDBInstanceActionConfig_basic(rName, true),
+				ExpectError: regexache.MustCompile(`InvalidParameterCombination`),
+			},
+		},
+	})
+}
+
+func testAccCheckRebootDBInstanceActionAvailable(ctx context.Context, t *testing.T, id string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).RDSClient(ctx)
+
+		output, err := tfrds.FindDBInstanceByID(ctx, conn, id)
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.ToString(output.DBInstanceStatus), "available"; got != want {
+			return fmt.Errorf("RDS DB Instance (%s) status = %s, want %s", id, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccRebootDBInstanceActionConfig_basic(rName string, forceFailover bool) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_basic(rName), fmt.Sprintf(`
+action "aws_rds_reboot_db_instance" "test" {
+  config {
+    db_instance_identifier = aws_db_instance.test.identifier
+    force_failover         = %[1]t
+  }
+}
+
+resource "terraform_data" "test" {
+  input = aws_db_instance.test.identifier
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.aws_rds_reboot_db_instance.test]
+    }
+  }
+}
+`, forceFailover))
+}
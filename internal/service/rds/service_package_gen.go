@@ -22,6 +22,40 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
+	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newCreateDBSnapshotAction,
+			TypeName: "aws_rds_create_db_snapshot",
+			Name:     "Create DB Snapshot",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newFailoverDBClusterAction,
+			TypeName: "aws_rds_failover_db_cluster",
+			Name:     "Failover DB Cluster",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newRebootDBInstanceAction,
+			TypeName: "aws_rds_reboot_db_instance",
+			Name:     "Reboot DB Instance",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+	}
+}
+
+func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
+	return []*inttypes.ServicePackageEphemeralResource{
+		{
+			Factory:  newGenerateDBAuthTokenEphemeralResource,
+			TypeName: "aws_rds_generate_db_auth_token",
+			Name:     "Generate DB Auth Token",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{
 		{
@@ -49,6 +49,7 @@ import (
 // @MutableIdentity
 // @ImportIDHandler("recordImportID")
 // @CustomImport
+// @ConcurrencyLimit(5)
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/route53/types;awstypes;awstypes.ResourceRecordSet")
 // @Testing(subdomainTfVar="zoneName;recordName")
 // @Testing(generator=false)
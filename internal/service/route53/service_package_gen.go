@@ -123,10 +123,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Region:   inttypes.ResourceRegionDisabled(),
 		},
 		{
-			Factory:  resourceRecord,
-			TypeName: "aws_route53_record",
-			Name:     "Record",
-			Region:   inttypes.ResourceRegionDisabled(),
+			Factory:          resourceRecord,
+			TypeName:         "aws_route53_record",
+			Name:             "Record",
+			Region:           inttypes.ResourceRegionDisabled(),
+			ConcurrencyLimit: 5,
 			Identity: inttypes.GlobalParameterizedIdentity([]inttypes.IdentityAttribute{
 				inttypes.StringIdentityAttribute("zone_id", true),
 				inttypes.StringIdentityAttributeWithMappedName(names.AttrName, true, "fqdn"),
@@ -0,0 +1,290 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	logstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	secretRotationStatusCompleted  actionwait.Status = "COMPLETED"
+	secretRotationStatusInProgress actionwait.Status = "IN_PROGRESS"
+)
+
+// rotationLambdaErrorsLimit bounds the number of log events included in diagnostics.
+const rotationLambdaErrorsLimit = 5
+
+// @Action(aws_secretsmanager_rotate_secret, name="Rotate Secret")
+func newRotateSecretAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &rotateSecretAction{}, nil
+}
+
+var (
+	_ action.Action = (*rotateSecretAction)(nil)
+)
+
+type rotateSecretAction struct {
+	framework.ActionWithModel[rotateSecretActionModel]
+}
+
+type rotateSecretActionModel struct {
+	framework.WithRegionModel
+	SecretID types.String `tfsdk:"secret_id"`
+	Timeout  types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *rotateSecretAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Immediately rotates a Secrets Manager secret using its configured rotation and waits for the new version to become current.",
+		Attributes: map[string]schema.Attribute{
+			"secret_id": schema.StringAttribute{
+				Description: "The ARN or name of the secret to rotate",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the rotation to complete (default: 900)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+	}
+}
+
+func (a *rotateSecretAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config rotateSecretActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SecretsManagerClient(ctx)
+
+	secretID := fwflex.StringValueFromFramework(ctx, config.SecretID)
+	timeout := fwactions.TimeoutOr(config.Timeout, 15*time.Minute)
+
+	tflog.Info(ctx, "Starting Secrets Manager rotate secret action", map[string]any{
+		"secret_id":       secretID,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting rotation of Secrets Manager secret %s...", secretID)
+
+	startedAt := time.Now()
+
+	input := secretsmanager.RotateSecretInput{
+		ClientRequestToken: aws.String(create.UniqueId(ctx)),
+		RotateImmediately:  aws.Bool(true),
+		SecretId:           aws.String(secretID),
+	}
+	output, err := conn.RotateSecret(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Rotate Secrets Manager Secret",
+			fmt.Sprintf("Could not start rotation of Secrets Manager secret %s: %s", secretID, err),
+		)
+		return
+	}
+
+	versionID := aws.ToString(output.VersionId)
+	cb(ctx, "Rotation of Secrets Manager secret %s started (version %s), waiting for it to complete...", secretID, versionID)
+
+	var (
+		rotationLambdaARN string
+		rotationLogs      *rotationLambdaLogs
+	)
+
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*secretsmanager.DescribeSecretOutput], error) {
+		secret, err := findSecretByID(ctx, conn, secretID)
+		if err != nil {
+			return actionwait.FetchResult[*secretsmanager.DescribeSecretOutput]{}, fmt.Errorf("describing secret: %w", err)
+		}
+
+		if slices.Contains(secret.VersionIdsToStages[versionID], secretVersionStageCurrent) {
+			return actionwait.FetchResult[*secretsmanager.DescribeSecretOutput]{Status: secretRotationStatusCompleted, Value: secret}, nil
+		}
+
+		// Managed rotation has no function whose logs can be inspected.
+		if rotationLogs == nil && aws.ToString(secret.RotationLambdaARN) != "" {
+			rotationLambdaARN = aws.ToString(secret.RotationLambdaARN)
+			rotationLogs = newRotationLambdaLogs(ctx, a.Meta().LambdaClient(ctx), a.Meta().LogsClient(ctx), rotationLambdaARN, versionID, startedAt)
+		}
+
+		if rotationLogs != nil && !rotationLogs.accessDenied {
+			if err := rotationLogs.readErrors(ctx); errs.IsA[*logstypes.AccessDeniedException](err) {
+				rotationLogs.accessDenied = true
+				resp.Diagnostics.AddWarning(
+					"Unable to Read Secrets Manager Rotation Function Logs",
+					fmt.Sprintf("Access denied reading log group %s of rotation function %s. Errors logged by the function while rotating Secrets Manager secret %s are not reported: %s", rotationLogs.logGroupName, rotationLambdaARN, secretID, err),
+				)
+			} else if err != nil {
+				tflog.Warn(ctx, "reading Secrets Manager rotation function logs", map[string]any{
+					"log_group_name": rotationLogs.logGroupName,
+					"error":          err.Error(),
+				})
+			}
+		}
+
+		return actionwait.FetchResult[*secretsmanager.DescribeSecretOutput]{Status: secretRotationStatusInProgress, Value: secret}, nil
+	}, actionwait.Options[*secretsmanager.DescribeSecretOutput]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(10 * time.Second),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{secretRotationStatusCompleted},
+		TransitionalStates: []actionwait.Status{secretRotationStatusInProgress},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if rotationLogs != nil && len(rotationLogs.errors) > 0 {
+				cb(ctx, "Rotation function %s logged errors for version %s of Secrets Manager secret %s, waiting for Secrets Manager to retry the rotation...", rotationLambdaARN, versionID, secretID)
+				return
+			}
+			cb(ctx, "Rotation of Secrets Manager secret %s is still in progress, waiting for version %s to become %s...", secretID, versionID, secretVersionStageCurrent)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			detail := fmt.Sprintf("Version %s of Secrets Manager secret %s did not become %s within %s. The rotation may still complete.", versionID, secretID, secretVersionStageCurrent, timeout)
+			if rotationLogs != nil {
+				if len(rotationLogs.errors) > 0 {
+					detail += fmt.Sprintf(" Rotation function %s logged these errors for the version:\n\n%s", rotationLambdaARN, strings.Join(rotationLogs.errors, "\n"))
+				} else {
+					detail += fmt.Sprintf(" Check the logs of rotation function %s for details.", rotationLambdaARN)
+				}
+			}
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Secrets Manager Secret Rotation",
+				detail,
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Secrets Manager Secret Rotation",
+				fmt.Sprintf("Error while waiting for rotation of Secrets Manager secret %s to complete: %s", secretID, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Rotation of Secrets Manager secret %s completed, version %s is now %s", secretID, versionID, secretVersionStageCurrent)
+
+	tflog.Info(ctx, "Secrets Manager rotate secret action completed successfully", map[string]any{
+		"secret_id":  secretID,
+		"version_id": versionID,
+	})
+}
+
+// rotationLambdaLogs reads the errors that a rotation function logs while rotating one secret version.
+// Only log events that contain the version ID, which is the rotation's ClientRequestToken, are read,
+// so errors logged for other secrets sharing the function are ignored. Errors that don't mention the
+// version, such as a function timeout, are not detected.
+type rotationLambdaLogs struct {
+	conn         *cloudwatchlogs.Client
+	logGroupName string
+	versionID    string
+	since        time.Time
+	errors       []string
+	accessDenied bool
+}
+
+func newRotationLambdaLogs(ctx context.Context, lambdaConn *lambda.Client, logsConn *cloudwatchlogs.Client, functionARN, versionID string, since time.Time) *rotationLambdaLogs {
+	return &rotationLambdaLogs{
+		conn:         logsConn,
+		logGroupName: rotationLambdaLogGroupName(ctx, lambdaConn, functionARN),
+		versionID:    versionID,
+		since:        since,
+	}
+}
+
+// readErrors records the errors logged for the version since the previous call.
+// A missing log group is not an error, as the function may not have logged anything yet.
+func (r *rotationLambdaLogs) readErrors(ctx context.Context) error {
+	input := cloudwatchlogs.FilterLogEventsInput{
+		FilterPattern: aws.String(strconv.Quote(r.versionID)),
+		LogGroupName:  aws.String(r.logGroupName),
+		StartTime:     aws.Int64(r.since.UnixMilli()),
+	}
+	pages := cloudwatchlogs.NewFilterLogEventsPaginator(r.conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*logstypes.ResourceNotFoundException](err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, event := range page.Events {
+			if v := time.UnixMilli(aws.ToInt64(event.Timestamp) + 1); v.After(r.since) {
+				r.since = v
+			}
+
+			message := strings.TrimSpace(aws.ToString(event.Message))
+			if len(r.errors) < rotationLambdaErrorsLimit && isRotationLambdaError(message) {
+				r.errors = append(r.errors, message)
+			}
+		}
+	}
+
+	return nil
+}
+
+// isRotationLambdaError returns whether a rotation function log message reports an error.
+func isRotationLambdaError(message string) bool {
+	return strings.Contains(message, "ERROR") || strings.Contains(message, "Exception")
+}
+
+func rotationLambdaLogGroupName(ctx context.Context, conn *lambda.Client, functionARN string) string {
+	input := lambda.GetFunctionConfigurationInput{
+		FunctionName: aws.String(functionARN),
+	}
+	output, err := conn.GetFunctionConfiguration(ctx, &input)
+
+	if err == nil {
+		if output.LoggingConfig != nil && output.LoggingConfig.LogGroup != nil {
+			return aws.ToString(output.LoggingConfig.LogGroup)
+		}
+
+		return "/aws/lambda/" + aws.ToString(output.FunctionName)
+	}
+
+	// Fall back to the default log group, derived from the function name in the ARN.
+	// Function ARN resources are of the form "function:<name>[:<qualifier>]".
+	functionName := functionARN
+	if v, err := arn.Parse(functionARN); err == nil {
+		if parts := strings.Split(v.Resource, ":"); len(parts) > 1 {
+			functionName = parts[1]
+		}
+	}
+
+	return "/aws/lambda/" + functionName
+}
@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSecretsManagerRotateSecretAction_rotationNotConfigured(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckSecretDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRotateSecretActionConfig_rotationNotConfigured(rName),
+				ExpectError: regexache.MustCompile(`Failed to Rotate Secrets Manager Secret`),
+			},
+		},
+	})
+}
+
+// The test rotation function is not a real rotation function, so the new version never becomes current.
+func TestAccSecretsManagerRotateSecretAction_timeout(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckSecretRotationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRotateSecretActionConfig_timeout(rName),
+				ExpectError: regexache.MustCompile(`Timeout Waiting for Secrets Manager Secret Rotation`),
+			},
+		},
+	})
+}
+
+func testAccRotateSecretActionConfig_rotationNotConfigured(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = "test-string"
+}
+
+action "aws_secretsmanager_rotate_secret" "test" {
+  config {
+    secret_id = aws_secretsmanager_secret_version.test.secret_id
+  }
+}
+
+resource "terraform_data" "test" {
+  input = aws_secretsmanager_secret_version.test.version_id
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.aws_secretsmanager_rotate_secret.test]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccRotateSecretActionConfig_timeout(rName string) string {
+	return acctest.ConfigCompose(testAccSecretRotationConfig_rotateImmediately(rName, 7), `
+action "aws_secretsmanager_rotate_secret" "test" {
+  config {
+    secret_id = aws_secretsmanager_secret_rotation.test.secret_id
+    timeout   = 60
+  }
+}
+
+resource "terraform_data" "test" {
+  input = aws_secretsmanager_secret_rotation.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.aws_secretsmanager_rotate_secret.test]
+    }
+  }
+}
+`)
+}
@@ -31,4 +31,7 @@ var (
 	FindPatchGroupByTwoPartKey                         = findPatchGroupByTwoPartKey
 	FindResourceDataSyncByName                         = findResourceDataSyncByName
 	FindServiceSettingByID                             = findServiceSettingByID
+
+	SummarizeStatusCounts = summarizeStatusCounts
+	TruncateActionOutput  = truncateActionOutput
 )
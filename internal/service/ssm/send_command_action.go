@@ -0,0 +1,496 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// sendCommandPollInterval defines polling cadence for the send command action.
+	sendCommandPollInterval = 10 * time.Second
+
+	// actionOutputMaxLength bounds the command output included in progress messages and diagnostics.
+	actionOutputMaxLength = 1000
+)
+
+// @Action(aws_ssm_send_command, name="Send Command")
+func newSendCommandAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &sendCommandAction{}, nil
+}
+
+var (
+	_ action.Action                     = (*sendCommandAction)(nil)
+	_ action.ActionWithConfigValidators = (*sendCommandAction)(nil)
+)
+
+type sendCommandAction struct {
+	framework.ActionWithModel[sendCommandActionModel]
+}
+
+type sendCommandActionModel struct {
+	framework.WithRegionModel
+	Comment         types.String                                       `tfsdk:"comment"`
+	DocumentName    types.String                                       `tfsdk:"document_name"`
+	DocumentVersion types.String                                       `tfsdk:"document_version"`
+	InstanceIDs     fwtypes.ListOfString                               `tfsdk:"instance_ids"`
+	MaxConcurrency  types.String                                       `tfsdk:"max_concurrency"`
+	MaxErrors       types.String                                       `tfsdk:"max_errors"`
+	Parameters      fwtypes.MapValueOf[fwtypes.ListOfString]           `tfsdk:"parameters" autoflex:"-"`
+	Targets         fwtypes.ListNestedObjectValueOf[actionTargetModel] `tfsdk:"targets"`
+	Timeout         types.Int64                                        `tfsdk:"timeout" autoflex:"-"`
+}
+
+type actionTargetModel struct {
+	Key    types.String         `tfsdk:"key"`
+	Values fwtypes.ListOfString `tfsdk:"values"`
+}
+
+func (a *sendCommandAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an SSM document on managed instances and waits for all command invocations to complete. The action fails if the command does not succeed.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrComment: schema.StringAttribute{
+				Description: "User-specified information about the command, such as a brief description of what the command should do",
+				Optional:    true,
+			},
+			"document_name": schema.StringAttribute{
+				Description: "Name, ARN or partial ARN of the SSM document to run, e.g. AWS-RunShellScript",
+				Required:    true,
+			},
+			"document_version": schema.StringAttribute{
+				Description: "Version of the SSM document to run. Valid values: $DEFAULT, $LATEST or a specific version number.",
+				Optional:    true,
+			},
+			"instance_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Description: "IDs of the managed instances to run the command on. Conflicts with targets.",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 50),
+				},
+			},
+			"max_concurrency": schema.StringAttribute{
+				Description: "Maximum number or percentage of instances the command can run on at the same time, e.g. 10 or 10%",
+				Optional:    true,
+			},
+			"max_errors": schema.StringAttribute{
+				Description: "Maximum number or percentage of errors allowed before the command stops being sent to additional instances",
+				Optional:    true,
+			},
+			names.AttrParameters: schema.MapAttribute{
+				CustomType:  fwtypes.NewMapTypeOf[fwtypes.ListOfString](ctx),
+				Description: "Parameters to pass to the SSM document, e.g. commands for AWS-RunShellScript",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the command to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"targets": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[actionTargetModel](ctx),
+				Description: "Targets the command by tag or resource group instead of by instance ID. Conflicts with instance_ids.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(5),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Description: "Target key, e.g. tag:Environment, InstanceIds or resource-groups:Name",
+							Required:    true,
+						},
+						names.AttrValues: schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							Description: "Target values, e.g. the tag values to match",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *sendCommandAction) ConfigValidators(context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.ExactlyOneOf(
+			path.MatchRoot("instance_ids"),
+			path.MatchRoot("targets"),
+		),
+	}
+}
+
+func (a *sendCommandAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config sendCommandActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := fwflex.StringValueFromFramework(ctx, config.DocumentName)
+	timeout := fwactions.TimeoutOr(config.Timeout, 30*time.Minute)
+
+	tflog.Info(ctx, "Starting SSM send command action", map[string]any{
+		"document_name":   documentName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Sending SSM command %s...", documentName)
+
+	var input ssm.SendCommandInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters, diags := expandActionParameters(ctx, config.Parameters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Parameters = parameters
+
+	output, err := conn.SendCommand(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Send SSM Command",
+			fmt.Sprintf("Could not send SSM command %s: %s", documentName, err),
+		)
+		return
+	}
+
+	commandID := aws.ToString(output.Command.CommandId)
+	cb(ctx, "SSM command %s sent, waiting for it to complete...", commandID)
+
+	// Report each invocation once, as soon as it reaches a terminal state.
+	reported := make(map[string]bool)
+	var invocations []awstypes.CommandInvocation
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Command], error) {
+		command, err := findCommandByID(ctx, conn, commandID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Command]{}, fmt.Errorf("listing command: %w", err)
+		}
+
+		input := ssm.ListCommandInvocationsInput{
+			CommandId: aws.String(commandID),
+			Details:   true,
+		}
+		invocations, err = findCommandInvocations(ctx, conn, &input)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Command]{}, fmt.Errorf("listing command invocations: %w", err)
+		}
+
+		for _, v := range invocations {
+			instanceID := aws.ToString(v.InstanceId)
+			if reported[instanceID] || !commandInvocationStatusIsTerminal(v.Status) {
+				continue
+			}
+			reported[instanceID] = true
+			cb(ctx, "%s", commandInvocationReport(ctx, conn, &v))
+		}
+
+		return actionwait.FetchResult[*awstypes.Command]{Status: actionwait.Status(command.Status), Value: command}, nil
+	}, actionwait.Options[*awstypes.Command]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(sendCommandPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.CommandStatusSuccess)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusPending),
+			actionwait.Status(awstypes.CommandStatusInProgress),
+			actionwait.Status(awstypes.CommandStatusCancelling),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusCancelled),
+			actionwait.Status(awstypes.CommandStatusFailed),
+			actionwait.Status(awstypes.CommandStatusTimedOut),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if command, ok := fr.Value.(*awstypes.Command); ok && command != nil {
+				cb(ctx, "SSM command %s is currently in state '%s' (%d of %d invocations completed, %d errors)...", commandID, fr.Status, command.CompletedCount, command.TargetCount, command.ErrorCount)
+			}
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for SSM Command",
+				fmt.Sprintf("SSM command %s did not complete within %s. The command has not been cancelled and may still be running.", commandID, timeout),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"SSM Command Failed",
+				fmt.Sprintf("SSM command %s finished with status %s (%s):\n%s", commandID, failureErr.Status, summarizeCommandInvocations(invocations), failedCommandInvocationsError(invocations)),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected SSM Command State",
+				fmt.Sprintf("SSM command %s entered unexpected state: %s", commandID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for SSM Command",
+				fmt.Sprintf("Error while waiting for SSM command %s to complete: %s", commandID, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "SSM command %s completed successfully on %d instance(s) (%s)", commandID, fr.Value.TargetCount, summarizeCommandInvocations(invocations))
+
+	tflog.Info(ctx, "SSM send command action completed successfully", map[string]any{
+		"command_id": commandID,
+	})
+}
+
+// commandInvocationReport returns a progress message describing a completed command invocation,
+// including the truncated standard output and standard error of each of its plugins.
+func commandInvocationReport(ctx context.Context, conn *ssm.Client, invocation *awstypes.CommandInvocation) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Instance %s: %s", aws.ToString(invocation.InstanceId), invocation.Status)
+	if v := aws.ToString(invocation.StatusDetails); v != "" && v != string(invocation.Status) {
+		fmt.Fprintf(&sb, " (%s)", v)
+	}
+
+	for _, plugin := range invocation.CommandPlugins {
+		pluginName := aws.ToString(plugin.Name)
+
+		// Prefer separate output streams; the invocation listing only contains their combined output.
+		var stdout, stderr string
+		output, err := findCommandInvocationByThreePartKey(ctx, conn, aws.ToString(invocation.CommandId), aws.ToString(invocation.InstanceId), pluginName)
+		if err == nil {
+			stdout, stderr = aws.ToString(output.StandardOutputContent), aws.ToString(output.StandardErrorContent)
+		} else {
+			stdout = aws.ToString(plugin.Output)
+		}
+
+		if v := strings.TrimSpace(stdout); v != "" {
+			fmt.Fprintf(&sb, "\n[%s] stdout:\n%s", pluginName, truncateActionOutput(v, actionOutputMaxLength))
+		}
+		if v := strings.TrimSpace(stderr); v != "" {
+			fmt.Fprintf(&sb, "\n[%s] stderr:\n%s", pluginName, truncateActionOutput(v, actionOutputMaxLength))
+		}
+	}
+
+	return sb.String()
+}
+
+func commandInvocationStatusIsTerminal(status awstypes.CommandInvocationStatus) bool {
+	switch status {
+	case awstypes.CommandInvocationStatusCancelled,
+		awstypes.CommandInvocationStatusFailed,
+		awstypes.CommandInvocationStatusSuccess,
+		awstypes.CommandInvocationStatusTimedOut:
+		return true
+	default:
+		return false
+	}
+}
+
+// summarizeCommandInvocations returns the number of command invocations in each status, e.g. "Failed: 1, Success: 2".
+func summarizeCommandInvocations(invocations []awstypes.CommandInvocation) string {
+	counts := make(map[string]int)
+	for _, v := range invocations {
+		counts[string(v.Status)]++
+	}
+
+	return summarizeStatusCounts(counts)
+}
+
+func summarizeStatusCounts(counts map[string]int) string {
+	if len(counts) == 0 {
+		return "no invocations"
+	}
+
+	summary := make([]string, 0, len(counts))
+	for _, status := range slices.Sorted(maps.Keys(counts)) {
+		summary = append(summary, fmt.Sprintf("%s: %d", status, counts[status]))
+	}
+
+	return strings.Join(summary, ", ")
+}
+
+// failedCommandInvocationsError returns an error describing each command invocation that did not succeed.
+func failedCommandInvocationsError(invocations []awstypes.CommandInvocation) error {
+	var errs []error
+
+	for _, v := range invocations {
+		if v.Status == awstypes.CommandInvocationStatusSuccess {
+			continue
+		}
+
+		err := fmt.Errorf("instance (%s): %s", aws.ToString(v.InstanceId), v.Status)
+		if details := aws.ToString(v.StatusDetails); details != "" && details != string(v.Status) {
+			err = fmt.Errorf("%w (%s)", err, details)
+		}
+		for _, plugin := range v.CommandPlugins {
+			if output := strings.TrimSpace(aws.ToString(plugin.Output)); output != "" && plugin.Status != awstypes.CommandPluginStatusSuccess {
+				err = fmt.Errorf("%w\n[%s] output:\n%s", err, aws.ToString(plugin.Name), truncateActionOutput(output, actionOutputMaxLength))
+			}
+		}
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// truncateActionOutput shortens s to at most n bytes, without splitting a UTF-8 encoded character.
+func truncateActionOutput(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+
+	return s[:n] + "\n... (output truncated)"
+}
+
+func expandActionParameters(ctx context.Context, tfMap fwtypes.MapValueOf[fwtypes.ListOfString]) (map[string][]string, diag.Diagnostics) { // nosemgrep:ci.semgrep.framework.manual-expander-functions
+	var diags diag.Diagnostics
+
+	if tfMap.IsNull() || tfMap.IsUnknown() {
+		return nil, diags
+	}
+
+	apiMap := make(map[string][]string, len(tfMap.Elements()))
+
+	diags.Append(tfMap.ElementsAs(ctx, &apiMap, false)...)
+
+	return apiMap, diags
+}
+
+func findCommandByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.Command, error) {
+	input := ssm.ListCommandsInput{
+		CommandId: aws.String(id),
+	}
+
+	return findCommand(ctx, conn, &input)
+}
+
+func findCommand(ctx context.Context, conn *ssm.Client, input *ssm.ListCommandsInput) (*awstypes.Command, error) {
+	output, err := findCommands(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findCommands(ctx context.Context, conn *ssm.Client, input *ssm.ListCommandsInput) ([]awstypes.Command, error) {
+	var output []awstypes.Command
+
+	pages := ssm.NewListCommandsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.InvalidCommandId](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Commands...)
+	}
+
+	return output, nil
+}
+
+func findCommandInvocations(ctx context.Context, conn *ssm.Client, input *ssm.ListCommandInvocationsInput) ([]awstypes.CommandInvocation, error) {
+	var output []awstypes.CommandInvocation
+
+	pages := ssm.NewListCommandInvocationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.InvalidCommandId](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CommandInvocations...)
+	}
+
+	return output, nil
+}
+
+func findCommandInvocationByThreePartKey(ctx context.Context, conn *ssm.Client, commandID, instanceID, pluginName string) (*ssm.GetCommandInvocationOutput, error) {
+	input := ssm.GetCommandInvocationInput{
+		CommandId:  aws.String(commandID),
+		InstanceId: aws.String(instanceID),
+		PluginName: aws.String(pluginName),
+	}
+
+	output, err := conn.GetCommandInvocation(ctx, &input)
+
+	if errs.IsA[*awstypes.InvocationDoesNotExist](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output, nil
+}
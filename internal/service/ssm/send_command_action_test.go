@@ -0,0 +1,294 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestTruncateActionOutput(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input string
+		n     int
+		want  string
+	}{
+		"short": {
+			input: "hello",
+			n:     10,
+			want:  "hello",
+		},
+		"exact": {
+			input: "hello",
+			n:     5,
+			want:  "hello",
+		},
+		"long": {
+			input: "hello world",
+			n:     5,
+			want:  "hello\n... (output truncated)",
+		},
+		"multibyte boundary": {
+			input: "héllo",
+			n:     2,
+			want:  "h\n... (output truncated)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfssm.TruncateActionOutput(testCase.input, testCase.n); got != testCase.want {
+				t.Errorf("TruncateActionOutput(%q, %d) = %q, want %q", testCase.input, testCase.n, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestSummarizeStatusCounts(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		counts map[string]int
+		want   string
+	}{
+		"empty": {
+			want: "no invocations",
+		},
+		"single": {
+			counts: map[string]int{"Success": 3},
+			want:   "Success: 3",
+		},
+		"multiple": {
+			counts: map[string]int{"TimedOut": 1, "Failed": 2, "Success": 3},
+			want:   "Failed: 2, Success: 3, TimedOut: 1",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfssm.SummarizeStatusCounts(testCase.counts); got != testCase.want {
+				t.Errorf("SummarizeStatusCounts() = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestAccSSMSendCommandAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check:  testAccCheckSendCommandActionRegistrationSleep(),
+			},
+			{
+				Config: testAccSendCommandActionConfig_instanceIDs(rName, "echo hello"),
+			},
+		},
+	})
+}
+
+func TestAccSSMSendCommandAction_targets(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check:  testAccCheckSendCommandActionRegistrationSleep(),
+			},
+			{
+				Config: testAccSendCommandActionConfig_targets(rName),
+			},
+		},
+	})
+}
+
+func TestAccSSMSendCommandAction_commandFailed(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check:  testAccCheckSendCommandActionRegistrationSleep(),
+			},
+			{
+				Config:      testAccSendCommandActionConfig_instanceIDs(rName, "echo oops >&2; exit 1"),
+				ExpectError: regexache.MustCompile(`(?s)SSM Command Failed.*oops`),
+			},
+		},
+	})
+}
+
+func TestAccSSMSendCommandAction_instanceIDsAndTargets(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSendCommandActionConfig_instanceIDsAndTargets(),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccCheckSendCommandActionRegistrationSleep() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		log.Print("[DEBUG] Test: Sleep to allow SSM Agent to register EC2 instance as a managed node.")
+		time.Sleep(1 * time.Minute)
+		return nil
+	}
+}
+
+func testAccSendCommandActionConfig_instanceIDs(rName, command string) string {
+	return acctest.ConfigCompose(testAccInstancesDataSourceConfig_filterInstance(rName), fmt.Sprintf(`
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name = "AWS-RunShellScript"
+    instance_ids  = [aws_instance.test.id]
+    comment       = %[1]q
+
+    parameters = {
+      commands = [%[2]q]
+    }
+  }
+}
+
+resource "terraform_data" "test" {
+  input = aws_instance.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+}
+`, rName, command))
+}
+
+func testAccSendCommandActionConfig_targets(rName string) string {
+	return acctest.ConfigCompose(testAccInstancesDataSourceConfig_filterInstance(rName), `
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name   = "AWS-RunShellScript"
+    max_concurrency = "50%"
+    max_errors      = "0"
+
+    targets {
+      key    = "tag:Name"
+      values = [aws_instance.test.tags["Name"]]
+    }
+
+    parameters = {
+      commands = ["uname -a"]
+    }
+  }
+}
+
+resource "terraform_data" "test" {
+  input = aws_instance.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+}
+`)
+}
+
+func testAccSendCommandActionConfig_instanceIDsAndTargets() string {
+	return `
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name = "AWS-RunShellScript"
+    instance_ids  = ["i-00000000000000000"]
+
+    targets {
+      key    = "tag:Name"
+      values = ["test"]
+    }
+
+    parameters = {
+      commands = ["uname -a"]
+    }
+  }
+}
+
+resource "terraform_data" "test" {
+  input = "test"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+}
+`
+}
@@ -0,0 +1,442 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// startAutomationExecutionPollInterval defines polling cadence for the start automation execution action.
+	startAutomationExecutionPollInterval = 15 * time.Second
+
+	// automationTargetParameterNameDefault is the runbook parameter that most AWS-provided runbooks target.
+	automationTargetParameterNameDefault = "InstanceId"
+	// automationTargetKeyParameterValues targets the runbook at explicit values of the target parameter.
+	automationTargetKeyParameterValues = "ParameterValues"
+)
+
+// @Action(aws_ssm_start_automation_execution, name="Start Automation Execution")
+func newStartAutomationExecutionAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startAutomationExecutionAction{}, nil
+}
+
+var (
+	_ action.Action                     = (*startAutomationExecutionAction)(nil)
+	_ action.ActionWithConfigValidators = (*startAutomationExecutionAction)(nil)
+)
+
+type startAutomationExecutionAction struct {
+	framework.ActionWithModel[startAutomationExecutionActionModel]
+}
+
+type startAutomationExecutionActionModel struct {
+	framework.WithRegionModel
+	DocumentName        types.String                                       `tfsdk:"document_name"`
+	DocumentVersion     types.String                                       `tfsdk:"document_version"`
+	InstanceIDs         fwtypes.ListOfString                               `tfsdk:"instance_ids" autoflex:"-"`
+	MaxConcurrency      types.String                                       `tfsdk:"max_concurrency"`
+	MaxErrors           types.String                                       `tfsdk:"max_errors"`
+	Parameters          fwtypes.MapValueOf[fwtypes.ListOfString]           `tfsdk:"parameters" autoflex:"-"`
+	TargetParameterName types.String                                       `tfsdk:"target_parameter_name" autoflex:"-"`
+	Targets             fwtypes.ListNestedObjectValueOf[actionTargetModel] `tfsdk:"targets"`
+	Timeout             types.Int64                                        `tfsdk:"timeout" autoflex:"-"`
+}
+
+func (a *startAutomationExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an SSM Automation runbook and waits for the execution to complete. The action fails if the execution does not succeed.",
+		Attributes: map[string]schema.Attribute{
+			"document_name": schema.StringAttribute{
+				Description: "Name or ARN of the Automation runbook to run, e.g. AWS-RestartEC2Instance",
+				Required:    true,
+			},
+			"document_version": schema.StringAttribute{
+				Description: "Version of the Automation runbook to run",
+				Optional:    true,
+			},
+			"instance_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Description: "IDs of the instances to run the runbook on, one child execution per instance. Conflicts with targets.",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"max_concurrency": schema.StringAttribute{
+				Description: "Maximum number or percentage of targets the runbook can run on at the same time, e.g. 10 or 10%",
+				Optional:    true,
+			},
+			"max_errors": schema.StringAttribute{
+				Description: "Maximum number or percentage of errors allowed before the runbook stops being run on additional targets",
+				Optional:    true,
+			},
+			names.AttrParameters: schema.MapAttribute{
+				CustomType:  fwtypes.NewMapTypeOf[fwtypes.ListOfString](ctx),
+				Description: "Parameters to pass to the Automation runbook",
+				Optional:    true,
+			},
+			"target_parameter_name": schema.StringAttribute{
+				Description: "Runbook parameter that receives each target, when instance_ids or targets is set (default: InstanceId)",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the execution to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"targets": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[actionTargetModel](ctx),
+				Description: "Targets the runbook by tag or resource group. Conflicts with instance_ids.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(5),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Description: "Target key, e.g. tag:Environment or ResourceGroup",
+							Required:    true,
+						},
+						names.AttrValues: schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							Description: "Target values, e.g. the tag values to match",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *startAutomationExecutionAction) ConfigValidators(context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.Conflicting(
+			path.MatchRoot("instance_ids"),
+			path.MatchRoot("targets"),
+		),
+	}
+}
+
+func (a *startAutomationExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startAutomationExecutionActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := fwflex.StringValueFromFramework(ctx, config.DocumentName)
+	timeout := fwactions.TimeoutOr(config.Timeout, 60*time.Minute)
+
+	tflog.Info(ctx, "Starting SSM start automation execution action", map[string]any{
+		"document_name":   documentName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting SSM automation execution of %s...", documentName)
+
+	var input ssm.StartAutomationExecutionInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters, diags := expandActionParameters(ctx, config.Parameters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Parameters = parameters
+
+	if instanceIDs := fwflex.ExpandFrameworkStringValueList(ctx, config.InstanceIDs); len(instanceIDs) > 0 {
+		input.Targets = []awstypes.Target{{
+			Key:    aws.String(automationTargetKeyParameterValues),
+			Values: instanceIDs,
+		}}
+	}
+
+	// Child executions are only created for targeted (rate-controlled) executions.
+	targeted := len(input.Targets) > 0
+	if targeted {
+		input.TargetParameterName = aws.String(automationTargetParameterNameDefault)
+		if v := fwflex.StringValueFromFramework(ctx, config.TargetParameterName); v != "" {
+			input.TargetParameterName = aws.String(v)
+		}
+	}
+
+	output, err := conn.StartAutomationExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start SSM Automation Execution",
+			fmt.Sprintf("Could not start SSM automation execution of %s: %s", documentName, err),
+		)
+		return
+	}
+
+	executionID := aws.ToString(output.AutomationExecutionId)
+	cb(ctx, "SSM automation execution %s started, waiting for it to complete...", executionID)
+
+	// Report each target (or step, for untargeted executions) once, as soon as it reaches a terminal state.
+	reported := make(map[string]bool)
+	var childExecutions []awstypes.AutomationExecutionMetadata
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.AutomationExecution], error) {
+		execution, err := findAutomationExecutionByID(ctx, conn, executionID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.AutomationExecution]{}, fmt.Errorf("getting automation execution: %w", err)
+		}
+
+		if targeted {
+			input := ssm.DescribeAutomationExecutionsInput{
+				Filters: []awstypes.AutomationExecutionFilter{{
+					Key:    awstypes.AutomationExecutionFilterKeyParentExecutionId,
+					Values: []string{executionID},
+				}},
+			}
+			childExecutions, err = findAutomationExecutions(ctx, conn, &input)
+			if err != nil {
+				return actionwait.FetchResult[*awstypes.AutomationExecution]{}, fmt.Errorf("listing child automation executions: %w", err)
+			}
+
+			for _, v := range childExecutions {
+				id := aws.ToString(v.AutomationExecutionId)
+				if reported[id] || !automationExecutionStatusIsTerminal(v.AutomationExecutionStatus) {
+					continue
+				}
+				reported[id] = true
+				cb(ctx, "%s", automationExecutionReport("Target "+aws.ToString(v.Target), v.AutomationExecutionStatus, v.FailureMessage, v.Outputs))
+			}
+		} else {
+			for _, v := range execution.StepExecutions {
+				id := aws.ToString(v.StepExecutionId)
+				if reported[id] || !automationExecutionStatusIsTerminal(v.StepStatus) {
+					continue
+				}
+				reported[id] = true
+				cb(ctx, "%s", automationExecutionReport("Step "+aws.ToString(v.StepName), v.StepStatus, v.FailureMessage, v.Outputs))
+			}
+		}
+
+		return actionwait.FetchResult[*awstypes.AutomationExecution]{Status: actionwait.Status(execution.AutomationExecutionStatus), Value: execution}, nil
+	}, actionwait.Options[*awstypes.AutomationExecution]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startAutomationExecutionPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusSuccess),
+			actionwait.Status(awstypes.AutomationExecutionStatusCompletedWithSuccess),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusPending),
+			actionwait.Status(awstypes.AutomationExecutionStatusInprogress),
+			actionwait.Status(awstypes.AutomationExecutionStatusWaiting),
+			actionwait.Status(awstypes.AutomationExecutionStatusCancelling),
+			actionwait.Status(awstypes.AutomationExecutionStatusPendingApproval),
+			actionwait.Status(awstypes.AutomationExecutionStatusApproved),
+			actionwait.Status(awstypes.AutomationExecutionStatusScheduled),
+			actionwait.Status(awstypes.AutomationExecutionStatusRunbookInprogress),
+			actionwait.Status(awstypes.AutomationExecutionStatusPendingChangeCalendarOverride),
+			actionwait.Status(awstypes.AutomationExecutionStatusChangeCalendarOverrideApproved),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusTimedout),
+			actionwait.Status(awstypes.AutomationExecutionStatusCancelled),
+			actionwait.Status(awstypes.AutomationExecutionStatusFailed),
+			actionwait.Status(awstypes.AutomationExecutionStatusRejected),
+			actionwait.Status(awstypes.AutomationExecutionStatusChangeCalendarOverrideRejected),
+			actionwait.Status(awstypes.AutomationExecutionStatusCompletedWithFailure),
+			actionwait.Status(awstypes.AutomationExecutionStatusExited),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if execution, ok := fr.Value.(*awstypes.AutomationExecution); ok && execution != nil && aws.ToString(execution.CurrentStepName) != "" {
+				cb(ctx, "SSM automation execution %s is currently in state '%s' at step %s...", executionID, fr.Status, aws.ToString(execution.CurrentStepName))
+			} else {
+				cb(ctx, "SSM automation execution %s is currently in state '%s'...", executionID, fr.Status)
+			}
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for SSM Automation Execution",
+				fmt.Sprintf("SSM automation execution %s did not complete within %s. The execution has not been stopped and may still be running.", executionID, timeout),
+			)
+		} else if errors.As(err, &failureErr) {
+			detail := fmt.Sprintf("SSM automation execution %s finished with status %s", executionID, failureErr.Status)
+			if execution := fr.Value; execution != nil && aws.ToString(execution.FailureMessage) != "" {
+				detail += ": " + truncateActionOutput(aws.ToString(execution.FailureMessage), actionOutputMaxLength)
+			}
+			if targeted {
+				detail += fmt.Sprintf("\n\nTargets (%s):\n%s", summarizeAutomationExecutions(childExecutions), failedAutomationExecutionsError(childExecutions))
+			}
+			resp.Diagnostics.AddError(
+				"SSM Automation Execution Failed",
+				detail,
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected SSM Automation Execution State",
+				fmt.Sprintf("SSM automation execution %s entered unexpected state: %s", executionID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for SSM Automation Execution",
+				fmt.Sprintf("Error while waiting for SSM automation execution %s to complete: %s", executionID, err),
+			)
+		}
+		return
+	}
+
+	if targeted {
+		cb(ctx, "SSM automation execution %s completed successfully (%s)", executionID, summarizeAutomationExecutions(childExecutions))
+	} else {
+		cb(ctx, "SSM automation execution %s completed successfully", executionID)
+	}
+
+	tflog.Info(ctx, "SSM start automation execution action completed successfully", map[string]any{
+		"automation_execution_id": executionID,
+	})
+}
+
+// automationExecutionReport returns a progress message describing a completed automation execution or step,
+// including its truncated failure message and outputs.
+func automationExecutionReport(subject string, status awstypes.AutomationExecutionStatus, failureMessage *string, outputs map[string][]string) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s: %s", subject, status)
+	if v := aws.ToString(failureMessage); v != "" {
+		fmt.Fprintf(&sb, "\nfailure: %s", truncateActionOutput(v, actionOutputMaxLength))
+	}
+	for _, k := range slices.Sorted(maps.Keys(outputs)) {
+		fmt.Fprintf(&sb, "\n%s: %s", k, truncateActionOutput(strings.Join(outputs[k], ", "), actionOutputMaxLength))
+	}
+
+	return sb.String()
+}
+
+func automationExecutionStatusIsTerminal(status awstypes.AutomationExecutionStatus) bool {
+	switch status {
+	case awstypes.AutomationExecutionStatusCancelled,
+		awstypes.AutomationExecutionStatusChangeCalendarOverrideRejected,
+		awstypes.AutomationExecutionStatusCompletedWithFailure,
+		awstypes.AutomationExecutionStatusCompletedWithSuccess,
+		awstypes.AutomationExecutionStatusExited,
+		awstypes.AutomationExecutionStatusFailed,
+		awstypes.AutomationExecutionStatusRejected,
+		awstypes.AutomationExecutionStatusSuccess,
+		awstypes.AutomationExecutionStatusTimedout:
+		return true
+	default:
+		return false
+	}
+}
+
+// summarizeAutomationExecutions returns the number of automation executions in each status, e.g. "Failed: 1, Success: 2".
+func summarizeAutomationExecutions(executions []awstypes.AutomationExecutionMetadata) string {
+	counts := make(map[string]int)
+	for _, v := range executions {
+		counts[string(v.AutomationExecutionStatus)]++
+	}
+
+	return summarizeStatusCounts(counts)
+}
+
+// failedAutomationExecutionsError returns an error describing each automation execution that did not succeed.
+func failedAutomationExecutionsError(executions []awstypes.AutomationExecutionMetadata) error {
+	var errs []error
+
+	for _, v := range executions {
+		switch v.AutomationExecutionStatus {
+		case awstypes.AutomationExecutionStatusSuccess, awstypes.AutomationExecutionStatusCompletedWithSuccess:
+			continue
+		}
+
+		err := fmt.Errorf("target (%s): %s", aws.ToString(v.Target), v.AutomationExecutionStatus)
+		if message := aws.ToString(v.FailureMessage); message != "" {
+			err = fmt.Errorf("%w: %s", err, truncateActionOutput(message, actionOutputMaxLength))
+		}
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+func findAutomationExecutionByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.AutomationExecution, error) {
+	input := ssm.GetAutomationExecutionInput{
+		AutomationExecutionId: aws.String(id),
+	}
+
+	output, err := conn.GetAutomationExecution(ctx, &input)
+
+	if errs.IsA[*awstypes.AutomationExecutionNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AutomationExecution == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output.AutomationExecution, nil
+}
+
+func findAutomationExecutions(ctx context.Context, conn *ssm.Client, input *ssm.DescribeAutomationExecutionsInput) ([]awstypes.AutomationExecutionMetadata, error) {
+	var output []awstypes.AutomationExecutionMetadata
+
+	pages := ssm.NewDescribeAutomationExecutionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AutomationExecutionMetadataList...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMStartAutomationExecutionAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDocumentDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartAutomationExecutionActionConfig_basic(rName),
+			},
+		},
+	})
+}
+
+func TestAccSSMStartAutomationExecutionAction_targets(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDocumentDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartAutomationExecutionActionConfig_targets(rName),
+			},
+		},
+	})
+}
+
+func TestAccSSMStartAutomationExecutionAction_executionFailed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDocumentDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartAutomationExecutionActionConfig_executionFailed(rName),
+				ExpectError: regexache.MustCompile(`(?s)SSM Automation Execution Failed.*ParameterNotFound`),
+			},
+		},
+	})
+}
+
+func testAccStartAutomationExecutionActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Automation"
+
+  content = <<DOC
+{
+  "schemaVersion": "0.3",
+  "parameters": {
+    "Duration": {
+      "type": "String",
+      "default": "PT1S"
+    }
+  },
+  "mainSteps": [
+    {
+      "name": "sleep",
+      "action": "aws:sleep",
+      "inputs": {
+        "Duration": "{{ Duration }}"
+      }
+    }
+  ]
+}
+DOC
+}
+
+action "aws_ssm_start_automation_execution" "test" {
+  config {
+    document_name = aws_ssm_document.test.name
+
+    parameters = {
+      Duration = ["PT5S"]
+    }
+  }
+}
+
+resource "terraform_data" "test" {
+  input = aws_ssm_document.test.name
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.aws_ssm_start_automation_execution.test]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccStartAutomationExecutionActionConfig_targets(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Automation"
+
+  content = <<DOC
+{
+  "schemaVersion": "0.3",
+  "parameters": {
+    "Duration": {
+      "type": "String"
+    }
+  },
+  "mainSteps": [
+    {
+      "name": "sleep",
+      "action": "aws:sleep",
+      "inputs": {
+        "Duration": "{{ Duration }}"
+      }
+    }
+  ]
+}
+DOC
+}
+
+action "aws_ssm_start_automation_execution" "test" {
+  config {
+    document_name         = aws_ssm_document.test.name
+    target_parameter_name = "Duration"
+    max_concurrency       = "2"
+    max_errors            = "0"
+
+    targets {
+      key    = "ParameterValues"
+      values = ["PT1S", "PT2S", "PT3S"]
+    }
+  }
+}
+
+resource "terraform_data" "test" {
+  input = aws_ssm_document.test.name
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.aws_ssm_start_automation_execution.test]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccStartAutomationExecutionActionConfig_executionFailed(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Automation"
+
+  content = <<DOC
+{
+  "schemaVersion": "0.3",
+  "mainSteps": [
+    {
+      "name": "getParameter",
+      "action": "aws:executeAwsApi",
+      "inputs": {
+        "Service": "ssm",
+        "Api": "GetParameter",
+        "Name": "/%[1]s/does-not-exist"
+      }
+    }
+  ]
+}
+DOC
+}
+
+action "aws_ssm_start_automation_execution" "test" {
+  config {
+    document_name = aws_ssm_document.test.name
+  }
+}
+
+resource "terraform_data" "test" {
+  input = aws_ssm_document.test.name
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.aws_ssm_start_automation_execution.test]
+    }
+  }
+}
+`, rName)
+}
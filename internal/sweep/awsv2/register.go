@@ -4,24 +4,24 @@
 package awsv2
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/runner"
 )
 
 func Register(name string, f sweep.SweeperFn, dependencies ...string) {
-	resource.AddTestSweepers(name, &resource.Sweeper{
+	sweep.Register(&runner.Sweeper{
 		Name: name,
-		F: func(region string) error {
-			ctx := sweep.Context(region)
+		Sweep: func(ctx context.Context, region string) (int, error) {
 			ctx = log.WithResourceType(ctx, name)
 
 			client, err := sweep.SharedRegionalSweepClient(ctx, region)
 			if err != nil {
-				return fmt.Errorf("getting client: %w", err)
+				return -1, fmt.Errorf("getting client: %w", err)
 			}
 			tflog.Info(ctx, "listing resources")
 			sweepResources, err := f(ctx, client)
@@ -30,18 +30,33 @@ func Register(name string, f sweep.SweeperFn, dependencies ...string) {
 				tflog.Warn(ctx, "Skipping sweeper", map[string]any{
 					"error": err.Error(),
 				})
-				return nil
+				return -1, runner.Skip(err)
 			}
 			if err != nil {
-				return fmt.Errorf("listing %q (%s): %w", name, region, err)
+				return -1, fmt.Errorf("listing %q (%s): %w", name, region, err)
+			}
+
+			if inventory, ok := runner.DryRunFromContext(ctx); ok {
+				for _, sweepable := range sweepResources {
+					inventory.Add(runner.InventoryItem{
+						ResourceType: name,
+						Region:       region,
+						ID:           sweep.SweepableID(sweepable),
+					})
+				}
+				tflog.Info(ctx, "Dry run, not sweeping resources", map[string]any{
+					"count": len(sweepResources),
+				})
+
+				return len(sweepResources), nil
 			}
 
 			err = sweep.SweepOrchestrator(ctx, sweepResources)
 			if err != nil {
-				return fmt.Errorf("sweeping %q (%s): %w", name, region, err)
+				return len(sweepResources), fmt.Errorf("sweeping %q (%s): %w", name, region, err)
 			}
 
-			return nil
+			return len(sweepResources), nil
 		},
 		Dependencies: dependencies,
 	})
@@ -11,8 +11,10 @@ import (
 )
 
 func Context(region string) context.Context {
-	ctx := context.Background()
+	return regionalContext(context.Background(), region)
+}
 
+func regionalContext(ctx context.Context, region string) context.Context {
 	ctx = tfsdklog.ContextWithStandardLogging(ctx, "sweep")
 
 	ctx = log.Logger(ctx, "sweeper", region)
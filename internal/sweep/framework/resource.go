@@ -5,6 +5,8 @@ package framework
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -46,6 +48,28 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 	}
 }
 
+// ID returns the value of the `id` attribute or, if there is none, the
+// comma-separated `name=value` pairs of all attributes.
+func (sr *sweepResource) ID() string {
+	parts := make([]string, 0, len(sr.attributes))
+	for _, attr := range sr.attributes {
+		var v string
+		switch value := attr.value.(type) {
+		case *string:
+			v = aws.ToString(value)
+		default:
+			v = fmt.Sprint(value)
+		}
+
+		if attr.path == names.AttrID {
+			return v
+		}
+		parts = append(parts, attr.path+"="+v)
+	}
+
+	return strings.Join(parts, ",")
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
 	resource, err := sr.factory(ctx)
 	if err != nil {
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"log"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/runner"
+)
+
+// sweepers holds every registered sweeper for the dependency-ordered runner.
+// Terraform Plugin Testing doesn't expose its own registry.
+var sweepers = make(map[string]*runner.Sweeper)
+
+// AddTestSweepers registers a sweeper with Terraform Plugin Testing and with the dependency-ordered runner.
+// Use in place of resource.AddTestSweepers.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	resource.AddTestSweepers(name, s)
+
+	addSweeper(&runner.Sweeper{
+		Name:         name,
+		Dependencies: s.Dependencies,
+		Sweep: func(ctx context.Context, region string) (int, error) {
+			if _, ok := runner.DryRunFromContext(ctx); ok {
+				return -1, runner.Skip(runner.ErrDryRunUnsupported)
+			}
+
+			return -1, s.F(region)
+		},
+	})
+}
+
+// Register registers a sweeper that reports the number of resources swept.
+func Register(s *runner.Sweeper) {
+	resource.AddTestSweepers(s.Name, &resource.Sweeper{
+		Name:         s.Name,
+		Dependencies: s.Dependencies,
+		F: func(region string) error {
+			_, err := s.Sweep(Context(region), region)
+			if runner.IsSkip(err) {
+				return nil
+			}
+			return err
+		},
+	})
+
+	addSweeper(&runner.Sweeper{
+		Name:         s.Name,
+		Dependencies: s.Dependencies,
+		Sweep: func(ctx context.Context, region string) (int, error) {
+			return s.Sweep(regionalContext(ctx, region), region)
+		},
+	})
+}
+
+func addSweeper(s *runner.Sweeper) {
+	if _, ok := sweepers[s.Name]; ok {
+		log.Fatalf("[ERR] Error adding (%s) to sweepers: sweeper already exists", s.Name)
+	}
+
+	sweepers[s.Name] = s
+}
+
+// Sweepers returns all registered sweepers.
+func Sweepers() map[string]*runner.Sweeper {
+	return maps.Clone(sweepers)
+}
@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package runner
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"sync"
+	"time"
+)
+
+// InventoryItem is a resource that a sweeper would delete.
+type InventoryItem struct {
+	ResourceType string `json:"resource_type"`
+	Region       string `json:"region"`
+	ID           string `json:"id"` // Empty if the sweeper can't identify the resource.
+}
+
+// Inventory collects the resources found by a dry run. Safe for concurrent use.
+type Inventory struct {
+	mu             sync.Mutex
+	items          []InventoryItem
+	notInventoried map[string]struct{}
+}
+
+// Add records resources that would be deleted.
+func (i *Inventory) Add(items ...InventoryItem) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.items = append(i.items, items...)
+}
+
+// Items returns the recorded resources ordered by resource type, Region and ID.
+func (i *Inventory) Items() []InventoryItem {
+	i.mu.Lock()
+	items := slices.Clone(i.items)
+	i.mu.Unlock()
+
+	slices.SortFunc(items, func(a, b InventoryItem) int {
+		return cmp.Or(
+			cmp.Compare(a.ResourceType, b.ResourceType),
+			cmp.Compare(a.Region, b.Region),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+
+	return items
+}
+
+func (i *Inventory) addNotInventoried(sweeper string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.notInventoried == nil {
+		i.notInventoried = make(map[string]struct{})
+	}
+	i.notInventoried[sweeper] = struct{}{}
+}
+
+// NotInventoried returns the sorted names of the sweepers that were skipped because they can't perform a dry run.
+// The inventory is incomplete if any are returned.
+func (i *Inventory) NotInventoried() []string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return slices.Sorted(maps.Keys(i.notInventoried))
+}
+
+// WriteJSON writes the inventory as indented JSON to path, replacing any existing file.
+func (i *Inventory) WriteJSON(path string) error {
+	v := struct {
+		GeneratedAt    time.Time       `json:"generated_at"`
+		Complete       bool            `json:"complete"`
+		NotInventoried []string        `json:"not_inventoried,omitempty"`
+		Resources      []InventoryItem `json:"resources"`
+	}{
+		GeneratedAt:    time.Now().UTC(),
+		NotInventoried: i.NotInventoried(),
+		Resources:      i.Items(),
+	}
+	v.Complete = len(v.NotInventoried) == 0
+	if v.Resources == nil {
+		v.Resources = []InventoryItem{}
+	}
+
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling sweeper inventory: %w", err)
+	}
+
+	return writeInventory(path, b)
+}
+
+// WriteCSV writes the inventory as CSV with a header row to path, replacing any existing file.
+func (i *Inventory) WriteCSV(path string) error {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write([]string{"resource_type", "region", "id"}); err != nil {
+		return fmt.Errorf("encoding sweeper inventory: %w", err)
+	}
+	for _, item := range i.Items() {
+		if err := w.Write([]string{item.ResourceType, item.Region, item.ID}); err != nil {
+			return fmt.Errorf("encoding sweeper inventory: %w", err)
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("encoding sweeper inventory: %w", err)
+	}
+
+	return writeInventory(path, buf.Bytes())
+}
+
+func writeInventory(path string, b []byte) error {
+	if err := os.WriteFile(path, b, 0o600); err != nil {
+		return fmt.Errorf("writing sweeper inventory (%s): %w", path, err)
+	}
+
+	return nil
+}
+
+type inventoryKey struct{}
+
+// NewDryRunContext returns a context indicating a dry run whose resources are recorded in inventory.
+func NewDryRunContext(ctx context.Context, inventory *Inventory) context.Context {
+	return context.WithValue(ctx, inventoryKey{}, inventory)
+}
+
+// DryRunFromContext returns the dry run inventory, if any, from ctx.
+// Sweepers must not delete anything when a dry run inventory is present.
+func DryRunFromContext(ctx context.Context) (*Inventory, bool) {
+	v, ok := ctx.Value(inventoryKey{}).(*Inventory)
+	return v, ok && v != nil
+}
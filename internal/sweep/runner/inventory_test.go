@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestInventory_WriteCSV(t *testing.T) {
+	t.Parallel()
+
+	var inventory Inventory
+	inventory.Add(
+		InventoryItem{ResourceType: "aws_vpc", Region: "us-west-2", ID: "vpc-2"},    //lintignore:AWSAT003
+		InventoryItem{ResourceType: "aws_vpc", Region: "us-east-1", ID: "vpc-1"},    //lintignore:AWSAT003
+		InventoryItem{ResourceType: "aws_iam_role", Region: "us-west-2", ID: "a,b"}, //lintignore:AWSAT003
+	)
+
+	path := filepath.Join(t.TempDir(), "inventory.csv")
+	if err := inventory.WriteCSV(path); err != nil {
+		t.Fatalf("WriteCSV: %v", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+
+	want := `resource_type,region,id
+aws_iam_role,us-west-2,"a,b"
+aws_vpc,us-east-1,vpc-1
+aws_vpc,us-west-2,vpc-2
+` //lintignore:AWSAT003
+	if diff := cmp.Diff(string(b), want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestInventory_WriteJSON(t *testing.T) {
+	t.Parallel()
+
+	var inventory Inventory
+	path := filepath.Join(t.TempDir(), "inventory.json")
+	if err := inventory.WriteJSON(path); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+
+	var got struct {
+		Complete  bool            `json:"complete"`
+		Resources []InventoryItem `json:"resources"`
+	}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !got.Complete {
+		t.Error("Complete = false, want true")
+	}
+	if got.Resources == nil || len(got.Resources) != 0 {
+		t.Errorf("Resources = %v, want empty non-nil slice", got.Resources)
+	}
+}
+
+func TestRunner_RunDryRun(t *testing.T) {
+	t.Parallel()
+
+	deleted := false
+	s := sweepers(
+		&Sweeper{
+			Name: "aws_vpc",
+			Sweep: func(ctx context.Context, region string) (int, error) {
+				inventory, ok := DryRunFromContext(ctx)
+				if !ok {
+					deleted = true
+					return 1, nil
+				}
+				inventory.Add(InventoryItem{ResourceType: "aws_vpc", Region: region, ID: "vpc-1"})
+				return 1, nil
+			},
+		},
+		&Sweeper{
+			Name: "aws_unavailable",
+			Sweep: func(ctx context.Context, region string) (int, error) {
+				return -1, Skip(errors.New("service not available in Region"))
+			},
+		},
+		&Sweeper{
+			Name: "aws_legacy",
+			Sweep: func(ctx context.Context, region string) (int, error) {
+				if _, ok := DryRunFromContext(ctx); ok {
+					return -1, Skip(ErrDryRunUnsupported)
+				}
+				deleted = true
+				return -1, nil
+			},
+		},
+	)
+
+	var inventory Inventory
+	runner, err := New(s, Options{Regions: []string{"us-west-2"}, DryRun: &inventory}) //lintignore:AWSAT003
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	report, err := runner.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if deleted {
+		t.Error("sweeper deleted resources during a dry run")
+	}
+	if !report.DryRun {
+		t.Error("DryRun = false, want true")
+	}
+	if got, want := report.Skipped, 2; got != want {
+		t.Errorf("Skipped = %d, want %d", got, want)
+	}
+	if diff := cmp.Diff(report.NotInventoried, []string{"aws_legacy"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+	if diff := cmp.Diff(inventory.NotInventoried(), []string{"aws_legacy"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+	if diff := cmp.Diff(inventory.Items(), []InventoryItem{{ResourceType: "aws_vpc", Region: "us-west-2", ID: "vpc-1"}}); diff != "" { //lintignore:AWSAT003
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
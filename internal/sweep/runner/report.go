@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package runner
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Status is the outcome of running one sweeper in one Region.
+type Status string
+
+const (
+	StatusSwept   Status = "swept"
+	StatusSkipped Status = "skipped"
+	StatusFailed  Status = "failed"
+)
+
+// Result is the outcome of running one sweeper in one Region.
+type Result struct {
+	Sweeper         string  `json:"sweeper"`
+	Region          string  `json:"region"`
+	Status          Status  `json:"status"`
+	Resources       *int    `json:"resources,omitempty"` // Number of resources found to sweep. Nil if the sweeper doesn't report it.
+	Reason          string  `json:"reason,omitempty"`    // Why the sweeper was skipped.
+	Error           string  `json:"error,omitempty"`
+	DurationSeconds float64 `json:"duration_seconds"`
+}
+
+// Report is the outcome of a Runner run, in the order the sweepers were started.
+type Report struct {
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	Regions    []string  `json:"regions"`
+	DryRun     bool      `json:"dry_run"`
+	Swept      int       `json:"swept"`
+	Skipped    int       `json:"skipped"`
+	Failed     int       `json:"failed"`
+	// NotInventoried are the sweepers skipped by a dry run because they can't perform one.
+	// Resources of these types are missing from the dry run inventory.
+	NotInventoried []string `json:"not_inventoried,omitempty"`
+	Results        []Result `json:"results"`
+}
+
+func (r *Report) add(result Result) {
+	switch result.Status {
+	case StatusSwept:
+		r.Swept++
+	case StatusSkipped:
+		r.Skipped++
+	case StatusFailed:
+		r.Failed++
+	}
+
+	r.Results = append(r.Results, result)
+}
+
+// Err returns an error describing every failed sweeper, or nil if none failed.
+func (r *Report) Err() error {
+	var errs []error
+
+	for _, result := range r.Results {
+		if result.Status == StatusFailed {
+			errs = append(errs, fmt.Errorf("sweeper (%s) in Region (%s): %s", result.Sweeper, result.Region, result.Error))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// WriteFile writes the report as indented JSON to path, replacing any
+// existing file.
+func (r *Report) WriteFile(path string) error {
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling sweeper report: %w", err)
+	}
+
+	if err := os.WriteFile(path, b, 0o600); err != nil {
+		return fmt.Errorf("writing sweeper report (%s): %w", path, err)
+	}
+
+	return nil
+}
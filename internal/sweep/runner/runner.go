@@ -0,0 +1,354 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package runner runs acceptance test sweepers in dependency order.
+//
+// Unlike the Terraform Plugin Testing sweeper runner, which walks each
+// sweeper's Dependencies recursively and runs one sweeper at a time, Runner
+// builds a dependency graph of all selected sweepers up front, rejects
+// dependency cycles and unknown dependencies, and then runs every sweeper whose
+// dependencies have completed concurrently, across all Regions, up to a fixed
+// parallelism. The outcome of each sweeper in each Region is recorded in a
+// Report.
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+)
+
+// DefaultParallelism is the number of sweepers run at once when no parallelism is specified.
+const DefaultParallelism = 10
+
+// Sweeper is a sweeper known to the runner.
+type Sweeper struct {
+	// Name is the sweeper's unique name, usually the resource type name.
+	Name string
+	// Dependencies are the names of the sweepers that must run before this one in the same Region.
+	Dependencies []string
+	// Sweep deletes the sweeper's resources in the specified Region.
+	// It returns the number of resources found to sweep, or -1 if the sweeper doesn't report a count.
+	// Return an error wrapped with Skip to record the sweeper as skipped.
+	Sweep func(ctx context.Context, region string) (int, error)
+}
+
+// ErrDryRunUnsupported is returned, wrapped with Skip, by sweepers that can't perform a dry run.
+// The Runner records such sweepers as not inventoried so that a dry run inventory isn't mistaken for a complete one.
+var ErrDryRunUnsupported = errors.New("sweeper does not support dry run")
+
+type skipError struct {
+	err error
+}
+
+func (e *skipError) Error() string {
+	return e.err.Error()
+}
+
+func (e *skipError) Unwrap() error {
+	return e.err
+}
+
+// Skip wraps err to indicate that the sweeper did not run, e.g. because the
+// service is not available in the Region.
+func Skip(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &skipError{err: err}
+}
+
+// IsSkip returns whether err, or any error in its tree, was wrapped with Skip.
+func IsSkip(err error) bool {
+	_, ok := errors.AsType[*skipError](err)
+	return ok
+}
+
+// Options configure a Runner.
+type Options struct {
+	// Regions are the Regions to sweep.
+	Regions []string
+	// Filter is a comma-separated list of sweeper names, or parts of names, to run.
+	// The dependencies of matching sweepers are also run. An empty Filter runs all sweepers.
+	// Matching follows the Terraform Plugin Testing -sweep-run flag.
+	Filter string
+	// Parallelism is the maximum number of sweepers run at once across all Regions.
+	// Defaults to DefaultParallelism.
+	Parallelism int
+	// AllowFailures continues sweeping after a sweeper fails, including the sweepers that depend on it.
+	// Otherwise no further sweepers are started once one fails.
+	AllowFailures bool
+	// DryRun, if set, makes the run a dry run: sweepers record the resources they
+	// would delete in DryRun instead of deleting them. Sweepers that can't
+	// perform a dry run are skipped and listed in the inventory and Report as not inventoried.
+	DryRun *Inventory
+}
+
+// Runner runs a set of sweepers in dependency order. Construct via New.
+type Runner struct {
+	options  Options
+	sweepers map[string]*Sweeper
+	order    []string // Dependencies before dependents.
+}
+
+// New returns a Runner for the sweepers selected by options.Filter.
+// An error is returned if a sweeper depends on an unknown sweeper or if the dependencies contain a cycle.
+func New(sweepers map[string]*Sweeper, options Options) (*Runner, error) {
+	if len(options.Regions) == 0 {
+		return nil, errors.New("no Regions to sweep")
+	}
+	if options.Parallelism <= 0 {
+		options.Parallelism = DefaultParallelism
+	}
+
+	selected, err := filter(sweepers, options.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := overallOrder(selected)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Runner{
+		options:  options,
+		sweepers: selected,
+		order:    order,
+	}, nil
+}
+
+// Sweepers returns the names of the sweepers that will run, dependencies first.
+func (r *Runner) Sweepers() []string {
+	return slices.Clone(r.order)
+}
+
+// filter returns the sweepers whose name contains any of the comma-separated
+// values in f, together with all their transitive dependencies.
+func filter(sweepers map[string]*Sweeper, f string) (map[string]*Sweeper, error) {
+	var patterns []string
+	for v := range strings.SplitSeq(strings.ToLower(f), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			patterns = append(patterns, v)
+		}
+	}
+
+	result := make(map[string]*Sweeper)
+	var add func(string, string) error
+	add = func(name, dependent string) error {
+		if _, ok := result[name]; ok {
+			return nil
+		}
+
+		s, ok := sweepers[name]
+		if !ok {
+			return fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", dependent, name)
+		}
+		result[name] = s
+
+		for _, dep := range s.Dependencies {
+			if err := add(dep, name); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	for name := range sweepers {
+		if len(patterns) > 0 && !slices.ContainsFunc(patterns, func(p string) bool {
+			return strings.Contains(strings.ToLower(name), p)
+		}) {
+			continue
+		}
+
+		if err := add(name, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// overallOrder returns the sweeper names ordered so that every sweeper follows its dependencies.
+func overallOrder(sweepers map[string]*Sweeper) ([]string, error) {
+	names := slices.Sorted(func(yield func(string) bool) {
+		for name := range sweepers {
+			if !yield(name) {
+				return
+			}
+		}
+	})
+
+	g := depgraph.New()
+	for _, name := range names {
+		g.AddNode(name)
+	}
+	for _, name := range names {
+		for _, dep := range sweepers[name].Dependencies {
+			if err := g.AddDependency(name, dep); err != nil {
+				return nil, fmt.Errorf("sweeper (%s): %w", name, err)
+			}
+		}
+	}
+
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, fmt.Errorf("ordering sweepers: %w", err)
+	}
+
+	return order, nil
+}
+
+type task struct {
+	region string
+	name   string
+}
+
+// Run runs the sweepers in every Region and returns the outcome of each.
+// The returned error is non-nil if any sweeper failed.
+func (r *Runner) Run(ctx context.Context) (*Report, error) {
+	report := &Report{
+		StartedAt: time.Now().UTC(),
+		Regions:   slices.Clone(r.options.Regions),
+		DryRun:    r.options.DryRun != nil,
+	}
+
+	if r.options.DryRun != nil {
+		ctx = NewDryRunContext(ctx, r.options.DryRun)
+	}
+
+	var (
+		mu      sync.Mutex
+		results = make(map[task]Result)
+		blocked = make(map[task]bool) // Failed, or not run because of a failure.
+		aborted bool
+		done    = make(map[task]chan struct{})
+		sem     = tfsync.NewSemaphore(r.options.Parallelism)
+		wg      sync.WaitGroup
+	)
+	for _, region := range r.options.Regions {
+		for _, name := range r.order {
+			done[task{region: region, name: name}] = make(chan struct{})
+		}
+	}
+
+	for _, region := range r.options.Regions {
+		for _, name := range r.order {
+			t := task{region: region, name: name}
+
+			wg.Go(func() {
+				defer close(done[t])
+
+				for _, dep := range r.sweepers[name].Dependencies {
+					<-done[task{region: region, name: dep}]
+				}
+
+				result := Result{
+					Sweeper: name,
+					Region:  region,
+				}
+
+				mu.Lock()
+				if aborted {
+					result.Status, result.Reason = StatusSkipped, "sweeping stopped after an earlier failure"
+				} else if !r.options.AllowFailures {
+					for _, dep := range r.sweepers[name].Dependencies {
+						if blocked[task{region: region, name: dep}] {
+							result.Status, result.Reason = StatusSkipped, fmt.Sprintf("dependency (%s) did not complete", dep)
+							break
+						}
+					}
+				}
+				mu.Unlock()
+
+				ran := result.Status == ""
+				if ran {
+					result = r.run(ctx, sem, t)
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				results[t] = result
+				if !ran || result.Status == StatusFailed {
+					blocked[t] = true
+				}
+				if result.Status == StatusFailed && !r.options.AllowFailures {
+					aborted = true
+				}
+			})
+		}
+	}
+
+	wg.Wait()
+
+	for _, region := range r.options.Regions {
+		for _, name := range r.order {
+			report.add(results[task{region: region, name: name}])
+		}
+	}
+	if r.options.DryRun != nil {
+		report.NotInventoried = r.options.DryRun.NotInventoried()
+	}
+	report.FinishedAt = time.Now().UTC()
+
+	return report, report.Err()
+}
+
+// run runs a single sweeper in a single Region.
+func (r *Runner) run(ctx context.Context, sem *tfsync.Semaphore, t task) Result {
+	result := Result{
+		Sweeper: t.name,
+		Region:  t.region,
+	}
+
+	if err := sem.Acquire(ctx); err != nil {
+		result.Status, result.Error = StatusFailed, err.Error()
+		return result
+	}
+	defer sem.Release()
+
+	tflog.Info(ctx, "Running sweeper", map[string]any{
+		"sweeper": t.name,
+		"region":  t.region,
+	})
+
+	start := time.Now()
+	n, err := r.sweepers[t.name].Sweep(ctx, t.region)
+	result.DurationSeconds = time.Since(start).Seconds()
+
+	if n >= 0 {
+		result.Resources = &n
+	}
+
+	switch {
+	case err == nil:
+		result.Status = StatusSwept
+	case IsSkip(err):
+		result.Status = StatusSkipped
+		result.Reason = err.Error()
+		if r.options.DryRun != nil && errors.Is(err, ErrDryRunUnsupported) {
+			r.options.DryRun.addNotInventoried(t.name)
+		}
+	default:
+		result.Status = StatusFailed
+		result.Error = err.Error()
+		tflog.Error(ctx, "Sweeper failed", map[string]any{
+			"sweeper": t.name,
+			"region":  t.region,
+			"error":   err.Error(),
+		})
+	}
+
+	return result
+}
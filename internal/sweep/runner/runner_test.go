@@ -0,0 +1,249 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package runner
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type recorder struct {
+	mu  sync.Mutex
+	ran map[string][]string // Region -> sweeper names, in order.
+}
+
+func (r *recorder) sweeper(name string, n int, err error, dependencies ...string) *Sweeper {
+	return &Sweeper{
+		Name:         name,
+		Dependencies: dependencies,
+		Sweep: func(_ context.Context, region string) (int, error) {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+
+			if r.ran == nil {
+				r.ran = make(map[string][]string)
+			}
+			r.ran[region] = append(r.ran[region], name)
+
+			return n, err
+		},
+	}
+}
+
+func sweepers(v ...*Sweeper) map[string]*Sweeper {
+	m := make(map[string]*Sweeper, len(v))
+	for _, s := range v {
+		m[s.Name] = s
+	}
+	return m
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	var r recorder
+	testCases := map[string]struct {
+		sweepers map[string]*Sweeper
+		filter   string
+		want     []string
+		wantErr  bool
+	}{
+		"order": {
+			sweepers: sweepers(
+				r.sweeper("aws_vpc", 0, nil, "aws_subnet"),
+				r.sweeper("aws_subnet", 0, nil, "aws_instance"),
+				r.sweeper("aws_instance", 0, nil),
+			),
+			want: []string{"aws_instance", "aws_subnet", "aws_vpc"},
+		},
+		"filter includes dependencies": {
+			sweepers: sweepers(
+				r.sweeper("aws_vpc", 0, nil, "aws_subnet"),
+				r.sweeper("aws_subnet", 0, nil),
+				r.sweeper("aws_iam_role", 0, nil),
+			),
+			filter: "AWS_VPC",
+			want:   []string{"aws_subnet", "aws_vpc"},
+		},
+		"cycle": {
+			sweepers: sweepers(
+				r.sweeper("aws_a", 0, nil, "aws_b"),
+				r.sweeper("aws_b", 0, nil, "aws_a"),
+			),
+			wantErr: true,
+		},
+		"unknown dependency": {
+			sweepers: sweepers(
+				r.sweeper("aws_a", 0, nil, "aws_missing"),
+			),
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			runner, err := New(testCase.sweepers, Options{Regions: []string{"us-west-2"}, Filter: testCase.filter}) //lintignore:AWSAT003
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("New() err = %v, wantErr %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(runner.Sweepers(), testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunner_Run(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	regions := []string{"us-west-2", "us-east-1"} //lintignore:AWSAT003
+	var r recorder
+
+	runner, err := New(sweepers(
+		r.sweeper("aws_vpc", 0, nil, "aws_subnet", "aws_internet_gateway"),
+		r.sweeper("aws_subnet", 3, nil, "aws_instance"),
+		r.sweeper("aws_internet_gateway", -1, Skip(errors.New("not available"))),
+		r.sweeper("aws_instance", 2, nil),
+	), Options{Regions: regions, Parallelism: 2})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	report, err := runner.Run(ctx)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	for _, region := range regions {
+		ran := r.ran[region]
+		if got, want := len(ran), 4; got != want {
+			t.Fatalf("%s: ran %d sweepers, want %d", region, got, want)
+		}
+		if i, j := slices.Index(ran, "aws_instance"), slices.Index(ran, "aws_subnet"); i > j {
+			t.Errorf("%s: aws_subnet ran before its dependency aws_instance: %v", region, ran)
+		}
+		if got, want := ran[len(ran)-1], "aws_vpc"; got != want {
+			t.Errorf("%s: last sweeper = %s, want %s", region, got, want)
+		}
+	}
+
+	if got, want := report.Swept, 6; got != want {
+		t.Errorf("Swept = %d, want %d", got, want)
+	}
+	if got, want := report.Skipped, 2; got != want {
+		t.Errorf("Skipped = %d, want %d", got, want)
+	}
+	if got, want := report.Failed, 0; got != want {
+		t.Errorf("Failed = %d, want %d", got, want)
+	}
+	for _, result := range report.Results {
+		if result.Sweeper == "aws_subnet" && (result.Resources == nil || *result.Resources != 3) {
+			t.Errorf("aws_subnet Resources = %v, want 3", result.Resources)
+		}
+		if result.Sweeper == "aws_internet_gateway" && result.Resources != nil {
+			t.Errorf("aws_internet_gateway Resources = %v, want nil", *result.Resources)
+		}
+	}
+}
+
+func TestRunner_RunFailure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := map[string]struct {
+		allowFailures bool
+		wantSwept     int
+		wantSkipped   int
+	}{
+		"stop": {
+			wantSkipped: 1,
+		},
+		"allow failures": {
+			allowFailures: true,
+			wantSwept:     1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var r recorder
+			runner, err := New(sweepers(
+				r.sweeper("aws_vpc", 0, nil, "aws_subnet"),
+				r.sweeper("aws_subnet", 0, errors.New("boom")),
+			), Options{Regions: []string{"us-west-2"}, AllowFailures: testCase.allowFailures}) //lintignore:AWSAT003
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+
+			report, err := runner.Run(ctx)
+			if err == nil {
+				t.Fatal("Run: expected error")
+			}
+
+			if got, want := report.Failed, 1; got != want {
+				t.Errorf("Failed = %d, want %d", got, want)
+			}
+			if got, want := report.Swept, testCase.wantSwept; got != want {
+				t.Errorf("Swept = %d, want %d", got, want)
+			}
+			if got, want := report.Skipped, testCase.wantSkipped; got != want {
+				t.Errorf("Skipped = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestRunner_RunParallelism(t *testing.T) {
+	t.Parallel()
+
+	var inFlight, peak atomic.Int32
+	s := make(map[string]*Sweeper)
+	for _, name := range []string{"aws_a", "aws_b", "aws_c", "aws_d", "aws_e"} {
+		s[name] = &Sweeper{
+			Name: name,
+			Sweep: func(context.Context, string) (int, error) {
+				n := inFlight.Add(1)
+				for {
+					p := peak.Load()
+					if n <= p || peak.CompareAndSwap(p, n) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				inFlight.Add(-1)
+
+				return 0, nil
+			},
+		}
+	}
+
+	runner, err := New(s, Options{Regions: []string{"us-west-2", "us-east-1"}, Parallelism: 3}) //lintignore:AWSAT003
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	if _, err := runner.Run(context.Background()); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if got, want := peak.Load(), int32(3); got > want {
+		t.Errorf("peak concurrency = %d, want at most %d", got, want)
+	}
+}
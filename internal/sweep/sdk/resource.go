@@ -39,6 +39,10 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return deleteResource(ctx, sr.resource, sr.d, sr.meta)
 }
 
+func (sr *sweepResource) ID() string {
+	return sr.d.Id()
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -31,8 +32,14 @@ var ServicePackages []conns.ServicePackage
 // This prevents client re-initialization for every resource with no benefit.
 var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
 
+// sweeperClientsLock guards sweeperClients, as the dependency-ordered runner sweeps concurrently.
+var sweeperClientsLock sync.Mutex
+
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	sweeperClientsLock.Lock()
+	defer sweeperClientsLock.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
@@ -104,6 +111,21 @@ type Sweepable interface {
 	Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error
 }
 
+// Identifier is implemented by Sweepables that can report the ID of the resource they delete.
+// The ID is recorded in dry run inventories.
+type Identifier interface {
+	ID() string
+}
+
+// SweepableID returns the ID of the resource that sweepable deletes, or "" if it isn't an Identifier.
+func SweepableID(sweepable Sweepable) string {
+	if v, ok := sweepable.(Identifier); ok {
+		return v.ID()
+	}
+
+	return ""
+}
+
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
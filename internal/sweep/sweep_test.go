@@ -5,10 +5,23 @@ package sweep_test
 
 import (
 	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/runner"
+)
+
+var (
+	flagSweepGraph       = flag.Bool("sweep-graph", false, "Run sweepers in dependency order, concurrently across Regions")
+	flagSweepParallelism = flag.Int("sweep-parallelism", runner.DefaultParallelism, "Maximum number of sweepers run at once with -sweep-graph")
+	flagSweepReport      = flag.String("sweep-report", "", "Path of a JSON report of the -sweep-graph run")
+	flagSweepDryRun      = flag.Bool("sweep-dry-run", false, "List the resources that would be swept without deleting them. Implies -sweep-graph")
+	flagSweepInventory   = flag.String("sweep-inventory", "", "Path of the -sweep-dry-run inventory. Written as CSV if the path ends in .csv, otherwise as JSON")
 )
 
 func TestMain(m *testing.M) {
@@ -18,5 +31,71 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
+	flag.Parse()
+	if *flagSweepGraph || *flagSweepDryRun {
+		os.Exit(runSweepGraph(ctx))
+	}
+
 	resource.TestMain(m)
 }
+
+// runSweepGraph runs the sweepers, or a dry run of them, with the dependency-ordered runner.
+// The -sweep, -sweep-run and -sweep-allow-failures flags registered by Terraform Plugin Testing are honored.
+func runSweepGraph(ctx context.Context) int {
+	regions := flag.Lookup("sweep").Value.String()
+	if regions == "" {
+		fmt.Fprintln(os.Stderr, "-sweep-graph and -sweep-dry-run require -sweep")
+		return 1
+	}
+
+	var inventory *runner.Inventory
+	if *flagSweepDryRun {
+		inventory = new(runner.Inventory)
+	}
+
+	r, err := runner.New(sweep.Sweepers(), runner.Options{
+		Regions:       strings.Split(regions, ","),
+		Filter:        flag.Lookup("sweep-run").Value.String(),
+		Parallelism:   *flagSweepParallelism,
+		AllowFailures: flag.Lookup("sweep-allow-failures").Value.String() == "true",
+		DryRun:        inventory,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "building sweeper graph: %s\n", err)
+		return 1
+	}
+
+	report, err := r.Run(ctx)
+
+	fmt.Printf("Sweepers: %d swept, %d skipped, %d failed\n", report.Swept, report.Skipped, report.Failed)
+	if *flagSweepReport != "" {
+		if err := report.WriteFile(*flagSweepReport); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+	}
+
+	if inventory != nil {
+		fmt.Printf("Dry run: %d resources would be swept\n", len(inventory.Items()))
+		if names := inventory.NotInventoried(); len(names) > 0 {
+			fmt.Printf("Dry run: inventory is incomplete, %d sweepers do not support dry run: %s\n", len(names), strings.Join(names, ", "))
+		}
+		if path := *flagSweepInventory; path != "" {
+			write := inventory.WriteJSON
+			if strings.HasSuffix(path, ".csv") {
+				write = inventory.WriteCSV
+			}
+			if err := write(path); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				return 1
+			}
+		}
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	return 0
+}
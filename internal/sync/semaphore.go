@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+)
+
+// Semaphore limits the number of concurrent holders.
+// It is the supported successor to the experimental semaphore in internal/experimental/sync
+// and is used to limit concurrent Create, Update and Delete operations per resource type.
+type Semaphore struct {
+	tokens chan struct{}
+}
+
+// NewSemaphore returns a Semaphore that admits at most n concurrent holders.
+// n must be positive.
+func NewSemaphore(n int) *Semaphore {
+	return &Semaphore{
+		tokens: make(chan struct{}, n),
+	}
+}
+
+// Limit returns the maximum number of concurrent holders.
+func (s *Semaphore) Limit() int {
+	return cap(s.tokens)
+}
+
+// Acquire blocks until the semaphore is acquired or ctx is done.
+func (s *Semaphore) Acquire(ctx context.Context) error {
+	select {
+	case s.tokens <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release releases a previously acquired semaphore.
+// Release panics if the semaphore is not held, as an unmatched Release would
+// otherwise hide a double release and admit more than the limit of holders.
+func (s *Semaphore) Release() {
+	select {
+	case <-s.tokens:
+	default:
+		panic("sync: release of unacquired semaphore")
+	}
+}
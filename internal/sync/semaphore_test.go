@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSemaphore(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	s := NewSemaphore(2)
+
+	if got, want := s.Limit(), 2; got != want {
+		t.Errorf("Limit() = %d, want %d", got, want)
+	}
+
+	for range 2 {
+		if err := s.Acquire(ctx); err != nil {
+			t.Fatalf("Acquire: %s", err)
+		}
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := s.Acquire(timeoutCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Acquire at capacity = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	s.Release()
+
+	if err := s.Acquire(ctx); err != nil {
+		t.Errorf("Acquire after Release: %s", err)
+	}
+}
+
+func TestSemaphore_ReleaseNotHeld(t *testing.T) {
+	t.Parallel()
+
+	s := NewSemaphore(1)
+
+	if err := s.Acquire(t.Context()); err != nil {
+		t.Fatalf("Acquire: %s", err)
+	}
+	s.Release()
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Release of unacquired semaphore did not panic")
+		}
+	}()
+
+	s.Release()
+}
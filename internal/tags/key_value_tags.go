@@ -78,6 +78,11 @@ type TagPolicyConfig struct {
 	// RequiredTags is a mapping of Terraform resource type names to the required
 	// tags defined in the effective tag policy
 	RequiredTags map[string]KeyValueTags
+
+	// TagRules is a mapping of Terraform resource type names to lowercase tag
+	// keys to the tag key capitalization and allowed tag value rules enforced
+	// for the resource type in the effective tag policy
+	TagRules map[string]map[string]TagPolicyRule
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// TagPolicyRule contains the compliance rules that a tag policy defines for a tag key.
+type TagPolicyRule struct {
+	// Key is the tag key with the capitalization that the tag policy treats as compliant.
+	Key string
+
+	// Values are the tag values that the tag policy treats as compliant.
+	// A "*" at the start or end of a value is a wildcard matching any characters,
+	// e.g. "200*" matches any value with the prefix "200" and "*@example.com" any
+	// value with the suffix "@example.com".
+	// If empty, any value is compliant.
+	Values []string
+}
+
+// NoncompliantTags returns a description of each tag that does not comply with
+// the tag key capitalization and allowed tag value rules that the tag policy
+// enforces for the specified Terraform resource type.
+// Tag keys are matched to rules without regard to capitalization.
+func (tpc *TagPolicyConfig) NoncompliantTags(typeName string, tags KeyValueTags) []string {
+	if tpc == nil || len(tpc.TagRules[typeName]) == 0 {
+		return nil
+	}
+
+	var noncompliant []string
+
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		rule, ok := tpc.TagRules[typeName][strings.ToLower(key)]
+		if !ok {
+			continue
+		}
+
+		if rule.Key != "" && key != rule.Key {
+			noncompliant = append(noncompliant, fmt.Sprintf("tag key %q must be capitalized as %q", key, rule.Key))
+		}
+
+		value := tags.KeyValue(key)
+		if value == nil || rule.AllowsValue(*value) {
+			continue
+		}
+
+		noncompliant = append(noncompliant, fmt.Sprintf("tag %q value %q is not one of the allowed values: %q", key, *value, rule.Values))
+	}
+
+	return noncompliant
+}
+
+// AllowsValue returns whether the rule treats the specified tag value as compliant.
+func (r TagPolicyRule) AllowsValue(value string) bool {
+	if len(r.Values) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(r.Values, func(v string) bool {
+		pattern, leading := strings.CutPrefix(v, "*")
+		pattern, trailing := strings.CutSuffix(pattern, "*")
+
+		switch {
+		case leading && trailing:
+			return strings.Contains(value, pattern)
+		case leading:
+			return strings.HasSuffix(value, pattern)
+		case trailing:
+			return strings.HasPrefix(value, pattern)
+		default:
+			return v == value
+		}
+	})
+}
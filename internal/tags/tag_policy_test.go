@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTagPolicyRuleAllowsValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		values []string
+		value  string
+		want   bool
+	}{
+		{
+			name:  "no allowed values",
+			value: "anything",
+			want:  true,
+		},
+		{
+			name:   "exact match",
+			values: []string{"prod", "dev"},
+			value:  "prod",
+			want:   true,
+		},
+		{
+			name:   "case mismatch",
+			values: []string{"prod", "dev"},
+			value:  "Prod",
+			want:   false,
+		},
+		{
+			name:   "wildcard match",
+			values: []string{"100", "200*"},
+			value:  "200-finance",
+			want:   true,
+		},
+		{
+			name:   "wildcard prefix mismatch",
+			values: []string{"100", "200*"},
+			value:  "300",
+			want:   false,
+		},
+		{
+			name:   "leading wildcard match",
+			values: []string{"*@example.com"},
+			value:  "finance@example.com",
+			want:   true,
+		},
+		{
+			name:   "leading wildcard mismatch",
+			values: []string{"*@example.com"},
+			value:  "finance@example.org",
+			want:   false,
+		},
+		{
+			name:   "leading and trailing wildcard match",
+			values: []string{"*finance*"},
+			value:  "team-finance-eu",
+			want:   true,
+		},
+		{
+			name:   "wildcard only",
+			values: []string{"*"},
+			value:  "",
+			want:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule := TagPolicyRule{Values: testCase.values}
+
+			if got := rule.AllowsValue(testCase.value); got != testCase.want {
+				t.Errorf("AllowsValue(%q) = %t, want %t", testCase.value, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestTagPolicyConfigNoncompliantTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy := &TagPolicyConfig{
+		TagRules: map[string]map[string]TagPolicyRule{
+			"aws_instance": {
+				"costcenter": {
+					Key:    "CostCenter",
+					Values: []string{"100", "200*"},
+				},
+				"environment": {
+					Values: []string{"prod", "dev"},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		policy   *TagPolicyConfig
+		typeName string
+		tags     KeyValueTags
+		want     []string
+	}{
+		{
+			name:   "nil policy",
+			policy: nil,
+			tags:   New(ctx, map[string]string{"environment": "Prod"}),
+		},
+		{
+			name:   "no rules",
+			policy: &TagPolicyConfig{},
+			tags:   New(ctx, map[string]string{"environment": "Prod"}),
+		},
+		{
+			name:     "compliant",
+			policy:   policy,
+			typeName: "aws_instance",
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "200-finance",
+				"environment": "prod",
+				"Other":       "anything",
+			}),
+		},
+		{
+			name:     "key capitalization",
+			policy:   policy,
+			typeName: "aws_instance",
+			tags:     New(ctx, map[string]string{"costcenter": "100"}),
+			want:     []string{`tag key "costcenter" must be capitalized as "CostCenter"`},
+		},
+		{
+			name:     "values",
+			policy:   policy,
+			typeName: "aws_instance",
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "300",
+				"environment": "Prod",
+			}),
+			want: []string{
+				`tag "CostCenter" value "300" is not one of the allowed values: ["100" "200*"]`,
+				`tag "environment" value "Prod" is not one of the allowed values: ["prod" "dev"]`,
+			},
+		},
+		{
+			name:     "key capitalization and value",
+			policy:   policy,
+			typeName: "aws_instance",
+			tags:     New(ctx, map[string]string{"COSTCENTER": "300"}),
+			want: []string{
+				`tag key "COSTCENTER" must be capitalized as "CostCenter"`,
+				`tag "COSTCENTER" value "300" is not one of the allowed values: ["100" "200*"]`,
+			},
+		},
+		{
+			name:     "not enforced for resource type",
+			policy:   policy,
+			typeName: "aws_s3_bucket",
+			tags:     New(ctx, map[string]string{"costcenter": "300"}),
+		},
+		{
+			name:     "unknown value",
+			policy:   policy,
+			typeName: "aws_instance",
+			tags:     New(ctx, map[string]*string{"environment": nil}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.policy.NoncompliantTags(testCase.typeName, testCase.tags)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
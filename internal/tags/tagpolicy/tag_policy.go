@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgtypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+const (
+	// allSupported is the tag policy resource type suffix that matches all
+	// supported resource types of a service, e.g. "ec2:ALL_SUPPORTED"
+	allSupported = "ALL_SUPPORTED"
+
+	// assignOperator is the tag policy inheritance operator that sets a value
+	assignOperator = "@@assign"
+)
+
+// tagPolicy is the content of a tag policy document.
+// Only the elements that the provider enforces are decoded.
+type tagPolicy struct {
+	Tags map[string]tagPolicyTag `json:"tags"`
+}
+
+type tagPolicyTag struct {
+	EnforcedFor          tagPolicyValue[[]string] `json:"enforced_for"`
+	ReportRequiredTagFor tagPolicyValue[[]string] `json:"report_required_tag_for"`
+	TagKey               tagPolicyValue[string]   `json:"tag_key"`
+	TagValue             tagPolicyValue[[]string] `json:"tag_value"`
+}
+
+// tagPolicyValue is a tag policy element value.
+// Effective tag policies contain plain values, whereas tag policies wrap
+// values in inheritance operators, e.g. {"@@assign": "CostCenter"}.
+type tagPolicyValue[T any] struct {
+	Value T
+}
+
+func (v *tagPolicyValue[T]) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		var operators map[string]json.RawMessage
+		if err := json.Unmarshal(data, &operators); err != nil {
+			return err
+		}
+
+		data = operators[assignOperator]
+		if data == nil {
+			return nil
+		}
+	}
+
+	return json.Unmarshal(data, &v.Value)
+}
+
+// GetTagRules retrieves the tag key capitalization and allowed tag value rules
+// per Terraform resource type from the effective tag policy of the calling account
+func GetTagRules(ctx context.Context, awsConfig aws.Config) (map[string]map[string]tftags.TagPolicyRule, error) {
+	client := organizations.NewFromConfig(awsConfig)
+	output, err := client.DescribeEffectivePolicy(ctx, &organizations.DescribeEffectivePolicyInput{
+		PolicyType: orgtypes.EffectivePolicyTypeTagPolicy,
+	})
+
+	if errs.IsA[*orgtypes.EffectivePolicyNotFoundException](err) || errs.IsA[*orgtypes.AWSOrganizationsNotInUseException](err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EffectivePolicy == nil {
+		return nil, nil
+	}
+
+	policy, err := parse(aws.ToString(output.EffectivePolicy.PolicyContent))
+	if err != nil {
+		return nil, err
+	}
+
+	return tagRules(policy), nil
+}
+
+// ReadFile reads a tag policy document from a local file and returns the
+// required tags per Terraform resource type together with the tag key
+// capitalization and allowed tag value rules per Terraform resource type
+// that it defines
+func ReadFile(ctx context.Context, filename string) (map[string]tftags.KeyValueTags, map[string]map[string]tftags.TagPolicyRule, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policy, err := parse(string(content))
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing %s: %w", filename, err)
+	}
+
+	return requiredTags(ctx, policy), tagRules(policy), nil
+}
+
+func parse(content string) (*tagPolicy, error) {
+	var policy tagPolicy
+
+	if err := json.Unmarshal([]byte(content), &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// tagRules translates a tag policy into rules keyed by Terraform resource type
+// and lowercase tag key. Rules are only enforced for the resource types in
+// the tag's enforced_for element, as with the tag policy itself.
+func tagRules(policy *tagPolicy) map[string]map[string]tftags.TagPolicyRule {
+	m := make(map[string]map[string]tftags.TagPolicyRule)
+	for name, tag := range policy.Tags {
+		rule := tftags.TagPolicyRule{
+			Key:    tag.TagKey.Value,
+			Values: tag.TagValue.Value,
+		}
+		if rule.Key == "" && len(rule.Values) == 0 {
+			continue
+		}
+
+		for _, tfType := range terraformTypes(tag.EnforcedFor.Value) {
+			if _, ok := m[tfType]; !ok {
+				m[tfType] = make(map[string]tftags.TagPolicyRule)
+			}
+			m[tfType][strings.ToLower(name)] = rule
+		}
+	}
+	return m
+}
+
+// requiredTags translates a tag policy into a map of required tags per
+// Terraform resource type
+func requiredTags(ctx context.Context, policy *tagPolicy) map[string]tftags.KeyValueTags {
+	m := make(map[string]tftags.KeyValueTags)
+	for name, tag := range policy.Tags {
+		key := tag.TagKey.Value
+		if key == "" {
+			key = name
+		}
+
+		newTags := tftags.New(ctx, []string{key})
+		for _, tfType := range terraformTypes(tag.ReportRequiredTagFor.Value) {
+			if v, ok := m[tfType]; ok {
+				m[tfType] = v.Merge(newTags)
+			} else {
+				m[tfType] = newTags
+			}
+		}
+	}
+	return m
+}
+
+// terraformTypes returns the Terraform resource types corresponding to tag
+// policy resource types, expanding "ALL_SUPPORTED" to every supported resource
+// type of the service
+func terraformTypes(resourceTypes []string) []string {
+	var tfTypes []string
+	for _, resourceType := range resourceTypes {
+		if service, ok := strings.CutSuffix(resourceType, ":"+allSupported); ok {
+			for k, v := range Lookup {
+				if strings.HasPrefix(k, service+":") {
+					tfTypes = append(tfTypes, v...)
+				}
+			}
+			continue
+		}
+
+		tfTypes = append(tfTypes, Lookup[resourceType]...)
+	}
+	return tfTypes
+}
@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestTagRules(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		content string
+		want    map[string]map[string]tftags.TagPolicyRule
+	}{
+		{
+			name: "effective policy",
+			content: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": "CostCenter",
+      "tag_value": ["100", "200*"],
+      "enforced_for": ["ec2:instance"]
+    },
+    "owner": {
+      "tag_key": "Owner",
+      "enforced_for": ["ec2:instance", "logs:log-group"]
+    },
+    "project": {
+      "report_required_tag_for": ["logs:log-group"]
+    },
+    "team": {
+      "tag_key": "Team"
+    }
+  }
+}`,
+			want: map[string]map[string]tftags.TagPolicyRule{
+				"aws_instance": {
+					"costcenter": {Key: "CostCenter", Values: []string{"100", "200*"}},
+					"owner":      {Key: "Owner"},
+				},
+				"aws_cloudwatch_log_group": {
+					"owner": {Key: "Owner"},
+				},
+			},
+		},
+		{
+			name: "policy with inheritance operators",
+			content: `{
+  "tags": {
+    "Environment": {
+      "tag_key": {
+        "@@assign": "Environment",
+        "@@operators_allowed_for_child_policies": ["@@none"]
+      },
+      "tag_value": {
+        "@@assign": ["prod", "dev"]
+      },
+      "enforced_for": {
+        "@@assign": ["secretsmanager:ALL_SUPPORTED"]
+      }
+    }
+  }
+}`,
+			want: map[string]map[string]tftags.TagPolicyRule{
+				"aws_secretsmanager_secret": {
+					"environment": {Key: "Environment", Values: []string{"prod", "dev"}},
+				},
+			},
+		},
+		{
+			name: "append operator only",
+			content: `{
+  "tags": {
+    "environment": {
+      "tag_value": {
+        "@@append": ["test"]
+      },
+      "enforced_for": ["ec2:instance"]
+    }
+  }
+}`,
+			want: map[string]map[string]tftags.TagPolicyRule{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy, err := parse(testCase.content)
+			if err != nil {
+				t.Fatalf("parsing policy: %s", err)
+			}
+
+			if diff := cmp.Diff(tagRules(policy), testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestReadFile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	filename := filepath.Join(t.TempDir(), "tag-policy.json")
+	content := `{
+  "tags": {
+    "owner": {
+      "tag_key": {
+        "@@assign": "Owner"
+      },
+      "report_required_tag_for": {
+        "@@assign": ["logs:log-group", "secretsmanager:ALL_SUPPORTED"]
+      }
+    },
+    "costcenter": {
+      "tag_value": {
+        "@@assign": ["100"]
+      },
+      "report_required_tag_for": {
+        "@@assign": ["logs:log-group"]
+      },
+      "enforced_for": {
+        "@@assign": ["logs:log-group"]
+      }
+    }
+  }
+}`
+	if err := os.WriteFile(filename, []byte(content), 0600); err != nil {
+		t.Fatalf("writing tag policy: %s", err)
+	}
+
+	reqTags, rules, err := ReadFile(ctx, filename)
+	if err != nil {
+		t.Fatalf("reading tag policy: %s", err)
+	}
+
+	wantRules := map[string]map[string]tftags.TagPolicyRule{
+		"aws_cloudwatch_log_group": {
+			"costcenter": {Values: []string{"100"}},
+		},
+	}
+	if diff := cmp.Diff(rules, wantRules); diff != "" {
+		t.Errorf("unexpected rules diff (+wanted, -got): %s", diff)
+	}
+
+	wantRequiredTags := map[string][]string{
+		"aws_cloudwatch_log_group":  {"Owner", "costcenter"},
+		"aws_secretsmanager_secret": {"Owner"},
+	}
+	for tfType, want := range wantRequiredTags {
+		got := reqTags[tfType].Keys()
+		slices.Sort(got)
+
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("unexpected required tags diff for %s (+wanted, -got): %s", tfType, diff)
+		}
+	}
+}
+
+func TestReadFile_invalid(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	filename := filepath.Join(t.TempDir(), "tag-policy.json")
+	if err := os.WriteFile(filename, []byte(`{"tags": [}`), 0600); err != nil {
+		t.Fatalf("writing tag policy: %s", err)
+	}
+
+	if _, _, err := ReadFile(ctx, filename); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
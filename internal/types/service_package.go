@@ -93,13 +93,14 @@ type ServicePackageFrameworkDataSource struct {
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory  func(context.Context) (resource.ResourceWithConfigure, error)
-	TypeName string
-	Name     string
-	Tags     unique.Handle[ServicePackageResourceTags]
-	Region   unique.Handle[ServicePackageResourceRegion]
-	Identity Identity
-	Import   FrameworkImport
+	Factory          func(context.Context) (resource.ResourceWithConfigure, error)
+	TypeName         string
+	Name             string
+	Tags             unique.Handle[ServicePackageResourceTags]
+	Region           unique.Handle[ServicePackageResourceRegion]
+	Identity         Identity
+	Import           FrameworkImport
+	ConcurrencyLimit int // Default maximum number of concurrent Create, Update and Delete operations. Zero means unlimited.
 }
 
 type ServicePackageFrameworkListResource struct {
@@ -124,13 +125,14 @@ type ServicePackageSDKDataSource struct {
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     unique.Handle[ServicePackageResourceTags]
-	Region   unique.Handle[ServicePackageResourceRegion]
-	Identity Identity
-	Import   SDKv2Import
+	Factory          func() *schema.Resource
+	TypeName         string
+	Name             string
+	Tags             unique.Handle[ServicePackageResourceTags]
+	Region           unique.Handle[ServicePackageResourceRegion]
+	Identity         Identity
+	Import           SDKv2Import
+	ConcurrencyLimit int // Default maximum number of concurrent Create, Update and Delete operations. Zero means unlimited.
 }
 
 type ListResourceForSDK interface {
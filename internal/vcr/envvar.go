@@ -14,8 +14,13 @@ const (
 	envVarVCRMode = "VCR_MODE"
 	envVarVCRPath = "VCR_PATH"
 
-	vcrModeRecordOnly = "RECORD_ONLY"
-	vcrModeReplayOnly = "REPLAY_ONLY"
+	envVarVCRRedactionRules = "VCR_REDACTION_RULES"
+	envVarVCRRedactionSalt  = "VCR_REDACTION_SALT"
+
+	vcrModeRecordOnly    = "RECORD_ONLY"
+	vcrModeReplayOnly    = "REPLAY_ONLY"
+	vcrModeRecordMissing = "RECORD_MISSING"
+	vcrModeVerify        = "VERIFY"
 )
 
 // IsEnabled indicates whether VCR testing is enabled
@@ -27,18 +32,41 @@ func IsEnabled() bool {
 }
 
 // Mode returns the VCR recording mode inferred from the VCR_MODE environment variable
+//
+// RECORD_MISSING replays recorded interactions and records any requests not
+// found in the cassette. VERIFY replays recorded interactions, as REPLAY_ONLY,
+// and additionally reports cassette drift (see IsVerify).
 func Mode() (recorder.Mode, error) {
 	switch v := os.Getenv(envVarVCRMode); v {
 	case vcrModeRecordOnly:
 		return recorder.ModeRecordOnly, nil
-	case vcrModeReplayOnly:
+	case vcrModeReplayOnly, vcrModeVerify:
 		return recorder.ModeReplayOnly, nil
+	case vcrModeRecordMissing:
+		return recorder.ModeReplayWithNewEpisodes, nil
 	default:
 		return recorder.ModePassthrough, fmt.Errorf("unsupported value for %s: %s", envVarVCRMode, v)
 	}
 }
 
+// IsVerify indicates whether cassettes are being verified
+//
+// Returns true if the VCR_MODE environment variable is set to VERIFY.
+func IsVerify() bool {
+	return os.Getenv(envVarVCRMode) == vcrModeVerify
+}
+
 // Path returns the directory in which VCR recordings should be stored
 func Path() string {
 	return os.Getenv(envVarVCRPath)
 }
+
+// RedactionRulesPath returns the path of the file of additional cassette redaction rules, if any
+func RedactionRulesPath() string {
+	return os.Getenv(envVarVCRRedactionRules)
+}
+
+// RedactionSalt returns the salt used when deriving replacements for redacted values
+func RedactionSalt() string {
+	return os.Getenv(envVarVCRRedactionSalt)
+}
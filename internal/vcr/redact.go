@@ -0,0 +1,608 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/netip"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+// Rule identifies sensitive values in recorded interactions.
+//
+// A rule has either a Pattern, applied to URLs, headers and bodies, or a
+// JSONPath, applied to JSON bodies. If Pattern has a capturing group only the
+// first group is replaced, otherwise the whole match is replaced.
+//
+// Replace must be deterministic, so that the same value is replaced
+// consistently across interactions and between recording and replay, and
+// idempotent, so that redacting a cassette twice changes nothing.
+type Rule struct {
+	Name     string
+	Pattern  *regexp.Regexp
+	JSONPath string
+	Replace  func(string) string
+}
+
+// Redactor applies redaction rules to interactions.
+//
+// When recording, sensitive values are learned from every captured interaction
+// (Learn) and the cassette is redacted just before it is saved (RedactInteraction),
+// so a value identified anywhere in the cassette is replaced everywhere.
+// When replaying, the live request is redacted before it is compared with the
+// recorded request (Redact) and values the provider sent are restored in the
+// replayed response (RestoreResponse), so that values originating from test
+// configuration round-trip unchanged.
+//
+// Safe for concurrent use.
+type Redactor struct {
+	rules []Rule
+
+	mu       sync.Mutex
+	learned  map[string]string // Original value -> replacement.
+	restore  map[string]string // Replacement -> original value; empty string if ambiguous.
+	replacer *strings.Replacer // Replaces all learned values, rebuilt when learned changes.
+}
+
+// NewRedactor returns a Redactor applying rules in order.
+func NewRedactor(rules ...Rule) *Redactor {
+	return &Redactor{
+		rules:   rules,
+		learned: make(map[string]string),
+		restore: make(map[string]string),
+	}
+}
+
+// minLearnedLength is the length below which learned values are only replaced
+// where a rule matches, not everywhere they occur.
+const minLearnedLength = 6
+
+func (r *Redactor) learn(original, replacement string) {
+	if original == replacement {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.learned[original]; ok {
+		return
+	}
+	r.learned[original] = replacement
+	r.replacer = nil
+
+	if v, ok := r.restore[replacement]; ok && v != original {
+		r.restore[replacement] = ""
+	} else {
+		r.restore[replacement] = original
+	}
+}
+
+func (r *Redactor) replaceLearned(s string) string {
+	r.mu.Lock()
+	if r.replacer == nil {
+		// Longest first, so that a value is never partially replaced by a shorter one it contains.
+		originals := slices.SortedFunc(maps.Keys(r.learned), func(a, b string) int {
+			return cmp.Or(cmp.Compare(len(b), len(a)), cmp.Compare(a, b))
+		})
+		var oldnew []string
+		for _, v := range originals {
+			if len(v) >= minLearnedLength {
+				oldnew = append(oldnew, v, r.learned[v])
+			}
+		}
+		r.replacer = strings.NewReplacer(oldnew...)
+	}
+	replacer := r.replacer
+	r.mu.Unlock()
+
+	return replacer.Replace(s)
+}
+
+// Redact returns s with sensitive values replaced.
+// JSON documents are also redacted using the rules' JSON paths.
+func (r *Redactor) Redact(s string) string {
+	if s == "" {
+		return s
+	}
+
+	s = r.redactJSON(s)
+
+	for _, rule := range r.rules {
+		if rule.Pattern != nil {
+			s = r.redactPattern(s, rule)
+		}
+	}
+
+	return r.replaceLearned(s)
+}
+
+func (r *Redactor) redactPattern(s string, rule Rule) string {
+	matches := rule.Pattern.FindAllStringSubmatchIndex(s, -1)
+	if len(matches) == 0 {
+		return s
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, m := range matches {
+		start, end := m[0], m[1]
+		if len(m) >= 4 && m[2] >= 0 {
+			start, end = m[2], m[3]
+		}
+
+		original := s[start:end]
+		replacement := rule.Replace(original)
+		r.learn(original, replacement)
+
+		b.WriteString(s[last:start])
+		b.WriteString(replacement)
+		last = end
+	}
+	b.WriteString(s[last:])
+
+	return b.String()
+}
+
+func (r *Redactor) redactJSON(s string) string {
+	if !slices.ContainsFunc(r.rules, func(rule Rule) bool { return rule.JSONPath != "" }) {
+		return s
+	}
+	if t := strings.TrimSpace(s); !strings.HasPrefix(t, "{") && !strings.HasPrefix(t, "[") {
+		return s
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return s
+	}
+
+	var changed bool
+	for _, rule := range r.rules {
+		if rule.JSONPath == "" {
+			continue
+		}
+
+		segments, err := parseJSONPath(rule.JSONPath)
+		if err != nil {
+			continue
+		}
+
+		v = walkJSONPath(v, segments, func(original string) string {
+			replacement := rule.Replace(original)
+			if replacement != original {
+				r.learn(original, replacement)
+				changed = true
+			}
+			return replacement
+		})
+	}
+
+	if !changed {
+		return s
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return s
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// Learn records the sensitive values in a captured interaction without modifying it.
+func (r *Redactor) Learn(i *cassette.Interaction) error {
+	for _, s := range interactionStrings(i) {
+		r.Redact(*s)
+	}
+
+	return nil
+}
+
+// RedactInteraction replaces sensitive values in the request and response of i.
+func (r *Redactor) RedactInteraction(i *cassette.Interaction) error {
+	for _, s := range interactionStrings(i) {
+		*s = r.Redact(*s)
+	}
+
+	return nil
+}
+
+// RestoreResponse replaces redacted values in the response of i with the
+// original values that the provider sent in live requests.
+func (r *Redactor) RestoreResponse(i *cassette.Interaction) error {
+	r.mu.Lock()
+	var oldnew []string
+	for replacement, original := range r.restore {
+		if original != "" && len(replacement) >= minLearnedLength {
+			oldnew = append(oldnew, replacement, original)
+		}
+	}
+	r.mu.Unlock()
+
+	if len(oldnew) == 0 {
+		return nil
+	}
+
+	replacer := strings.NewReplacer(oldnew...)
+	i.Response.Body = replacer.Replace(i.Response.Body)
+	for _, values := range i.Response.Headers {
+		for j, v := range values {
+			values[j] = replacer.Replace(v)
+		}
+	}
+
+	return nil
+}
+
+// interactionStrings returns pointers to the redactable strings of an interaction.
+func interactionStrings(i *cassette.Interaction) []*string {
+	v := []*string{
+		&i.Request.URL,
+		&i.Request.Host,
+		&i.Request.RequestURI,
+		&i.Request.Body,
+		&i.Response.Body,
+	}
+
+	for _, header := range []map[string][]string{i.Request.Headers, i.Request.Form, i.Response.Headers} {
+		for _, values := range header {
+			for j := range values {
+				v = append(v, &values[j])
+			}
+		}
+	}
+
+	return v
+}
+
+type jsonPathSegment struct {
+	key       string // "*" matches any key or element.
+	recursive bool   // Matches key at any depth.
+}
+
+// parseJSONPath parses the subset of JSONPath supported by redaction rules:
+// "$", ".key", "..key", ".*" and "[*]", e.g. "$.Credentials.SecretAccessKey" or "$..SessionToken".
+func parseJSONPath(path string) ([]jsonPathSegment, error) {
+	rest, ok := strings.CutPrefix(path, "$")
+	if !ok {
+		return nil, fmt.Errorf("JSON path (%s) must start with $", path)
+	}
+
+	var segments []jsonPathSegment
+	for rest != "" {
+		var segment jsonPathSegment
+		switch {
+		case strings.HasPrefix(rest, "[*]"):
+			segment.key, rest = "*", rest[3:]
+			segments = append(segments, segment)
+			continue
+		case strings.HasPrefix(rest, ".."):
+			segment.recursive, rest = true, rest[2:]
+		case strings.HasPrefix(rest, "."):
+			rest = rest[1:]
+		default:
+			return nil, fmt.Errorf("invalid JSON path (%s)", path)
+		}
+
+		end := strings.IndexAny(rest, ".[")
+		if end == -1 {
+			end = len(rest)
+		}
+		if end == 0 {
+			return nil, fmt.Errorf("invalid JSON path (%s)", path)
+		}
+		segment.key, rest = rest[:end], rest[end:]
+		segments = append(segments, segment)
+	}
+
+	return segments, nil
+}
+
+// walkJSONPath calls replace for every string value in v selected by segments and returns the updated value.
+func walkJSONPath(v any, segments []jsonPathSegment, replace func(string) string) any {
+	if len(segments) == 0 {
+		if s, ok := v.(string); ok {
+			return replace(s)
+		}
+		return v
+	}
+
+	segment, rest := segments[0], segments[1:]
+
+	switch v := v.(type) {
+	case map[string]any:
+		for k, child := range v {
+			if segment.key == "*" || segment.key == k {
+				v[k] = walkJSONPath(child, rest, replace)
+			}
+			if segment.recursive {
+				v[k] = walkJSONPath(v[k], segments, replace)
+			}
+		}
+	case []any:
+		for j, child := range v {
+			if segment.key == "*" {
+				v[j] = walkJSONPath(child, rest, replace)
+			}
+			if segment.recursive {
+				v[j] = walkJSONPath(v[j], segments, replace)
+			}
+		}
+	}
+
+	return v
+}
+
+// Replacement functions.
+
+func digest(salt, s string) []byte {
+	h := sha256.Sum256([]byte(salt + s))
+	return h[:]
+}
+
+// ReplaceWith returns a replacement function that replaces every value with s.
+func ReplaceWith(s string) func(string) string {
+	return func(string) string {
+		return s
+	}
+}
+
+const redactedPrefix = "REDACTED"
+
+// ReplaceWithToken returns a replacement function that replaces each value
+// with a token derived from the value, e.g. "REDACTED-0123456789ab".
+func ReplaceWithToken(salt string) func(string) string {
+	return func(s string) string {
+		if strings.HasPrefix(s, redactedPrefix) {
+			return s
+		}
+
+		return redactedPrefix + "-" + hex.EncodeToString(digest(salt, s))[:12]
+	}
+}
+
+// fakeAccountIDPrefix starts every replacement account ID.
+// No real AWS account ID is expected to start with it.
+const fakeAccountIDPrefix = "0000"
+
+// ReplaceAccountID returns a replacement function that replaces a 12-digit
+// AWS account ID with a fake account ID derived from it.
+func ReplaceAccountID(salt string) func(string) string {
+	return func(s string) string {
+		if strings.HasPrefix(s, fakeAccountIDPrefix) {
+			return s
+		}
+
+		return fmt.Sprintf("%s%08d", fakeAccountIDPrefix, binary.BigEndian.Uint64(digest(salt, s))%100_000_000)
+	}
+}
+
+const fakeAccessKeySuffix = "EXAMPLE"
+
+// ReplaceAccessKeyID returns a replacement function that replaces an AWS access
+// key ID, or other IAM unique ID, with a fake ID of the same kind derived from it.
+func ReplaceAccessKeyID(salt string) func(string) string {
+	return func(s string) string {
+		if len(s) != 20 || strings.HasSuffix(s, fakeAccessKeySuffix) {
+			return s
+		}
+
+		return s[:4] + base32.StdEncoding.EncodeToString(digest(salt, s))[:9] + fakeAccessKeySuffix
+	}
+}
+
+var documentationPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+}
+
+// ReplaceIPAddress returns a replacement function that replaces a public IPv4
+// address with an address in the 203.0.113.0/24 documentation range derived from it.
+// Private, loopback, link-local, multicast and documentation addresses are not replaced.
+func ReplaceIPAddress(salt string) func(string) string {
+	return func(s string) string {
+		addr, err := netip.ParseAddr(s)
+		if err != nil || !addr.Is4() || addr.IsPrivate() || addr.IsLoopback() || addr.IsLinkLocalUnicast() || addr.IsMulticast() || addr.IsUnspecified() {
+			return s
+		}
+		if slices.ContainsFunc(documentationPrefixes, func(p netip.Prefix) bool { return p.Contains(addr) }) {
+			return s
+		}
+
+		return fmt.Sprintf("203.0.113.%d", digest(salt, s)[0]%254+1)
+	}
+}
+
+// arnNamespaces returns the ARN namespaces of all services, from names/data.
+var arnNamespaces = sync.OnceValue(func() []string {
+	serviceData, err := data.ReadAllServiceData()
+	if err != nil {
+		return nil
+	}
+
+	var namespaces []string
+	for _, sd := range serviceData {
+		if v := sd.ARNNamespace(); v != "" && !slices.Contains(namespaces, v) {
+			namespaces = append(namespaces, v)
+		}
+	}
+	// Namespaces of services not in names/data, or that differ from the SDK's.
+	for _, v := range []string{"s3", "sts"} {
+		if !slices.Contains(namespaces, v) {
+			namespaces = append(namespaces, v)
+		}
+	}
+	slices.Sort(namespaces)
+
+	return namespaces
+})
+
+// DefaultRules returns the built-in redaction rules:
+//
+//   - AWS access key IDs and IAM unique IDs
+//   - secret access keys and session tokens in JSON and XML bodies
+//   - signatures, credentials and security tokens in presigned URLs
+//   - AWS account IDs in ARNs of any service in names/data, in account ID fields and in URL paths
+//   - public IPv4 addresses
+func DefaultRules(salt string) []Rule {
+	quoted := make([]string, 0, len(arnNamespaces()))
+	for _, v := range arnNamespaces() {
+		quoted = append(quoted, regexp.QuoteMeta(v))
+	}
+
+	return []Rule{
+		{
+			Name:    "aws-access-key-id",
+			Pattern: regexp.MustCompile(`\b((?:AKIA|ASIA|AIDA|AROA|AIPA|ANPA|ANVA|AGPA)[A-Z0-9]{16})\b`),
+			Replace: ReplaceAccessKeyID(salt),
+		},
+		{
+			Name:     "aws-secret-access-key",
+			JSONPath: "$..SecretAccessKey",
+			Replace:  ReplaceWith(redactedPrefix),
+		},
+		{
+			Name:     "aws-secret-key",
+			JSONPath: "$..SecretKey",
+			Replace:  ReplaceWith(redactedPrefix),
+		},
+		{
+			Name:     "aws-session-token",
+			JSONPath: "$..SessionToken",
+			Replace:  ReplaceWith(redactedPrefix),
+		},
+		{
+			Name:    "aws-credentials-xml",
+			Pattern: regexp.MustCompile(`<(?:SecretAccessKey|SessionToken)>([^<]+)</`),
+			Replace: ReplaceWith(redactedPrefix),
+		},
+		{
+			Name:    "presigned-url",
+			Pattern: regexp.MustCompile(`(?i)[?&](?:amp;)?X-Amz-(?:Signature|Credential|Security-Token)=([^&"'\s<]+)`),
+			Replace: ReplaceWith(redactedPrefix),
+		},
+		{
+			Name:    "aws-account-id-arn",
+			Pattern: regexp.MustCompile(`arn:aws[a-z-]*:(?:` + strings.Join(quoted, "|") + `):[a-z0-9-]*:(\d{12}):`),
+			Replace: ReplaceAccountID(salt),
+		},
+		{
+			Name:    "aws-account-id-field",
+			Pattern: regexp.MustCompile(`(?:<(?:Account|AccountId|OwnerId|ownerId)>|"(?:Account|AccountId|OwnerId|accountId|ownerId)"\s*:\s*")(\d{12})\b`),
+			Replace: ReplaceAccountID(salt),
+		},
+		{
+			Name:    "aws-account-id-url",
+			Pattern: regexp.MustCompile(`\.amazonaws\.com(?:\.cn)?/(\d{12})/`),
+			Replace: ReplaceAccountID(salt),
+		},
+		{
+			Name:    "ipv4-address",
+			Pattern: regexp.MustCompile(`\b(\d{1,3}(?:\.\d{1,3}){3})\b`),
+			Replace: ReplaceIPAddress(salt),
+		},
+	}
+}
+
+// RedactionConfig is the format of the file named by VCR_REDACTION_RULES.
+type RedactionConfig struct {
+	// DisableDefaultRules disables the rules returned by DefaultRules.
+	DisableDefaultRules bool `json:"disable_default_rules"`
+	// Rules are applied after the default rules.
+	Rules []RedactionRuleConfig `json:"rules"`
+}
+
+// RedactionRuleConfig configures a Rule.
+// Exactly one of Regex and JSONPath must be set.
+type RedactionRuleConfig struct {
+	Name     string `json:"name"`
+	Regex    string `json:"regex"`
+	JSONPath string `json:"json_path"`
+	// Replacement replaces every matched value. If empty, each value is replaced
+	// with a token derived from it, e.g. "REDACTED-0123456789ab".
+	Replacement string `json:"replacement"`
+}
+
+// redactionRules returns the rules configured by c.
+func (c RedactionConfig) redactionRules(salt string) ([]Rule, error) {
+	var rules []Rule
+	if !c.DisableDefaultRules {
+		rules = append(rules, DefaultRules(salt)...)
+	}
+
+	for i, v := range c.Rules {
+		name := cmp.Or(v.Name, fmt.Sprintf("rules[%d]", i))
+		rule := Rule{
+			Name:     name,
+			JSONPath: v.JSONPath,
+			Replace:  ReplaceWithToken(salt),
+		}
+		if v.Replacement != "" {
+			rule.Replace = ReplaceWith(v.Replacement)
+		}
+
+		switch {
+		case (v.Regex == "") == (v.JSONPath == ""):
+			return nil, fmt.Errorf("redaction rule (%s): exactly one of regex and json_path must be set", name)
+		case v.Regex != "":
+			re, err := regexp.Compile(v.Regex)
+			if err != nil {
+				return nil, fmt.Errorf("redaction rule (%s): %w", name, err)
+			}
+			rule.Pattern = re
+		default:
+			if _, err := parseJSONPath(v.JSONPath); err != nil {
+				return nil, fmt.Errorf("redaction rule (%s): %w", name, err)
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// NewRedactorFromEnv returns a Redactor using the default rules and any rules
+// in the file named by the VCR_REDACTION_RULES environment variable.
+func NewRedactorFromEnv() (*Redactor, error) {
+	var config RedactionConfig
+
+	if path := RedactionRulesPath(); path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s (%s): %w", envVarVCRRedactionRules, path, err)
+		}
+		if err := json.Unmarshal(b, &config); err != nil {
+			return nil, fmt.Errorf("parsing %s (%s): %w", envVarVCRRedactionRules, path, err)
+		}
+	}
+
+	rules, err := config.redactionRules(RedactionSalt())
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRedactor(rules...), nil
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+// Verifier replays a cassette and reports drift between the cassette and the
+// requests made by the provider: requests that no longer match any recorded
+// interaction and recorded interactions that were never used.
+//
+// Register UnusedInteractionHook as a recorder.OnRecorderStopHook on the
+// replaying recorder and use the Verifier as the HTTP client's transport.
+type Verifier struct {
+	Recorder *recorder.Recorder
+
+	mu        sync.Mutex
+	unmatched []string
+	unused    []string
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (v *Verifier) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := v.Recorder.RoundTrip(req)
+
+	if errors.Is(err, cassette.ErrInteractionNotFound) {
+		s := req.Method + " " + req.URL.String()
+		if target := req.Header.Get("X-Amz-Target"); target != "" {
+			s += " (" + target + ")"
+		}
+
+		v.mu.Lock()
+		// The request may be retried.
+		if !slices.Contains(v.unmatched, s) {
+			v.unmatched = append(v.unmatched, s)
+		}
+		v.mu.Unlock()
+	}
+
+	return resp, err
+}
+
+// UnusedInteractionHook is a recorder stop hook that records interactions that were never replayed.
+func (v *Verifier) UnusedInteractionHook(i *cassette.Interaction) error {
+	if i.WasReplayed() {
+		return nil
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.unused = append(v.unused, fmt.Sprintf("#%d %s %s", i.ID, i.Request.Method, i.Request.URL))
+
+	return nil
+}
+
+// Stop stops the recorder and returns an error describing any drift.
+func (v *Verifier) Stop() error {
+	if err := v.Recorder.Stop(); err != nil {
+		return err
+	}
+
+	return v.Err()
+}
+
+// Err returns an error describing any drift detected so far, or nil if there is none.
+func (v *Verifier) Err() error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if len(v.unmatched) == 0 && len(v.unused) == 0 {
+		return nil
+	}
+
+	var b strings.Builder
+	b.WriteString("cassette drift detected")
+	if len(v.unmatched) > 0 {
+		fmt.Fprintf(&b, "\n%d requests not found in cassette:", len(v.unmatched))
+		for _, s := range v.unmatched {
+			b.WriteString("\n  " + s)
+		}
+	}
+	if len(v.unused) > 0 {
+		fmt.Fprintf(&b, "\n%d cassette interactions never used:", len(v.unused))
+		for _, s := range v.unused {
+			b.WriteString("\n  " + s)
+		}
+	}
+
+	return errors.New(b.String())
+}
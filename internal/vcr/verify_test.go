@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+func TestMode(t *testing.T) {
+	testCases := map[string]struct {
+		value         string
+		want          recorder.Mode
+		wantVerify    bool
+		expectedError bool
+	}{
+		"record only": {
+			value: "RECORD_ONLY",
+			want:  recorder.ModeRecordOnly,
+		},
+		"replay only": {
+			value: "REPLAY_ONLY",
+			want:  recorder.ModeReplayOnly,
+		},
+		"record missing": {
+			value: "RECORD_MISSING",
+			want:  recorder.ModeReplayWithNewEpisodes,
+		},
+		"verify": {
+			value:      "VERIFY",
+			want:       recorder.ModeReplayOnly,
+			wantVerify: true,
+		},
+		"invalid": {
+			value:         "RECORD",
+			want:          recorder.ModePassthrough,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(envVarVCRMode, testCase.value)
+
+			got, err := Mode()
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("expected error %t, got %s", want, err)
+			}
+			if got != testCase.want {
+				t.Errorf("got mode %d, want %d", got, testCase.want)
+			}
+			if got, want := IsVerify(), testCase.wantVerify; got != want {
+				t.Errorf("got IsVerify %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestVerifier(t *testing.T) {
+	t.Parallel()
+
+	cassetteName := filepath.Join(t.TempDir(), "test")
+	if err := os.WriteFile(cassetteName+".yaml", []byte(`---
+version: 2
+interactions:
+  - id: 0
+    request:
+      method: GET
+      url: https://example.com/used
+    response:
+      code: 200
+  - id: 1
+    request:
+      method: GET
+      url: https://example.com/unused
+    response:
+      code: 200
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	verifier := new(Verifier)
+	r, err := recorder.New(cassetteName,
+		recorder.WithMode(recorder.ModeReplayOnly),
+		recorder.WithHook(verifier.UnusedInteractionHook, recorder.OnRecorderStopHook),
+		recorder.WithMatcher(func(r *http.Request, i cassette.Request) bool {
+			return r.Method == i.Method && r.URL.String() == i.URL
+		}),
+		recorder.WithSkipRequestLatency(true),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifier.Recorder = r
+
+	client := &http.Client{Transport: verifier}
+
+	resp, err := client.Get("https://example.com/used")
+	if err != nil {
+		t.Fatalf("replaying recorded request: %s", err)
+	}
+	resp.Body.Close()
+
+	if _, err := client.Get("https://example.com/new"); err == nil {
+		t.Fatal("expected error replaying unrecorded request")
+	}
+
+	err = verifier.Stop()
+	if err == nil {
+		t.Fatal("expected drift error")
+	}
+
+	for _, want := range []string{
+		"1 requests not found in cassette:\n  GET https://example.com/new",
+		"1 cassette interactions never used:\n  #1 GET https://example.com/unused",
+	} {
+		if got := err.Error(); !strings.Contains(got, want) {
+			t.Errorf("error %q does not contain %q", got, want)
+		}
+	}
+}
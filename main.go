@@ -10,6 +10,7 @@ import (
 	"runtime/debug"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
@@ -26,7 +27,8 @@ func main() {
 		log.Printf("Starting %s@%s (%s)...", buildInfo.Main.Path, version.ProviderVersion, buildInfo.GoVersion)
 	}
 
-	serverFactory, _, err := provider.ProtoV5ProviderServerFactory(context.Background())
+	ctx := context.Background()
+	serverFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
 
 	if err != nil {
 		log.Fatal(err)
@@ -47,4 +49,11 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+
+	// Terraform has shut the provider down; flush any requested API call report.
+	if c, ok := primary.Meta().(*conns.AWSClient); ok {
+		if err := c.WriteAPICallReport(ctx); err != nil {
+			log.Printf("[ERROR] %s", err)
+		}
+	}
 }
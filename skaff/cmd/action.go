@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/action"
+	"github.com/spf13/cobra"
+)
+
+var actionCmd = &cobra.Command{
+	Use:   "action",
+	Short: "Create scaffolding for an action",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return action.Create(name, snakeName, !clearComments, force)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(actionCmd)
+	actionCmd.Flags().StringVarP(&snakeName, "snakename", "s", "", "if skaff doesn't get it right, explicitly give name in snake case (e.g., db_vpc_instance)")
+	actionCmd.Flags().BoolVarP(&clearComments, "clear-comments", "c", false, "do not include instructional comments in source")
+	actionCmd.Flags().StringVarP(&name, "name", "n", "", "name of the entity")
+	actionCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
+}
@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/migrate"
+	"github.com/spf13/cobra"
+)
+
+var typeName string
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Create a Plugin Framework resource from an existing Plugin SDKv2 resource",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return migrate.Create(name, typeName, !clearComments, force)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(migrateCmd)
+	migrateCmd.Flags().BoolVarP(&clearComments, "clear-comments", "c", false, "do not include instructional comments in source")
+	migrateCmd.Flags().StringVarP(&name, "name", "n", "", "name of the existing resource, without the \"resource\" prefix (e.g., FlowLog)")
+	migrateCmd.Flags().StringVarP(&typeName, "type", "t", "", "if the resource function isn't found by name, the resource type name (e.g., aws_flow_log)")
+	migrateCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
+}
@@ -0,0 +1,635 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+// primitiveType describes the Plugin Framework equivalents of an SDKv2 primitive value type.
+type primitiveType struct {
+	Attribute    string // e.g. "StringAttribute"
+	GoType       string // e.g. "types.String"
+	ElementType  string // e.g. "types.StringType"
+	Null         string // e.g. "types.StringNull()"
+	PlanModifier string // e.g. "stringplanmodifier"
+	Default      string // e.g. "stringdefault"
+	Static       string // e.g. "StaticString"
+}
+
+var primitiveTypes = map[string]primitiveType{
+	"Bool": {
+		Attribute:    "BoolAttribute",
+		GoType:       "types.Bool",
+		ElementType:  "types.BoolType",
+		Null:         "types.BoolNull()",
+		PlanModifier: "boolplanmodifier",
+		Default:      "booldefault",
+		Static:       "StaticBool",
+	},
+	"Float": {
+		Attribute:    "Float64Attribute",
+		GoType:       "types.Float64",
+		ElementType:  "types.Float64Type",
+		Null:         "types.Float64Null()",
+		PlanModifier: "float64planmodifier",
+		Default:      "float64default",
+		Static:       "StaticFloat64",
+	},
+	"Int": {
+		Attribute:    "Int64Attribute",
+		GoType:       "types.Int64",
+		ElementType:  "types.Int64Type",
+		Null:         "types.Int64Null()",
+		PlanModifier: "int64planmodifier",
+		Default:      "int64default",
+		Static:       "StaticInt64",
+	},
+	"String": {
+		Attribute:    "StringAttribute",
+		GoType:       "types.String",
+		ElementType:  "types.StringType",
+		Null:         "types.StringNull()",
+		PlanModifier: "stringplanmodifier",
+		Default:      "stringdefault",
+		Static:       "StaticString",
+	},
+}
+
+// fwField is a field of a Plugin Framework model struct.
+type fwField struct {
+	GoName    string
+	GoType    string
+	TFName    string
+	Null      string // Go expression for a null value
+	Normalize string // Go expression, with %[1]s the prior value, converting an SDKv2 zero value to null
+	Empty     bool   // Whether an empty SDKv2 collection should be converted to null
+}
+
+// fwModel is a Plugin Framework model struct.
+type fwModel struct {
+	Name   string
+	Fields []fwField
+}
+
+// generator generates Plugin Framework source from an SDKv2 resource definition.
+type generator struct {
+	goNames    map[string]string // Attribute name to Go name, e.g. "kms_key_id" -> "KMSKeyID"
+	caps       map[string]string // Incorrect to correct capitalization, e.g. "Kms" -> "KMS"
+	models     []*fwModel
+	modelNames map[string]bool
+	modelPaths map[string]string // Path of a nested attribute in the current schema to its model name
+}
+
+func newGenerator(goNames, caps map[string]string) *generator {
+	return &generator{
+		goNames:    goNames,
+		caps:       caps,
+		modelNames: make(map[string]bool),
+		modelPaths: make(map[string]string),
+	}
+}
+
+// takeModels returns the nested models generated since the last call.
+func (g *generator) takeModels() []*fwModel {
+	models := g.models
+	g.models = nil
+
+	return models
+}
+
+// goName returns the Go field name for the specified attribute name.
+func (g *generator) goName(name string) string {
+	if v, ok := g.goNames[name]; ok {
+		return v
+	}
+
+	var sb strings.Builder
+	for part := range strings.SplitSeq(name, "_") {
+		if part == "" {
+			continue
+		}
+		word := strings.ToUpper(part[:1]) + part[1:]
+		if v, ok := g.caps[word]; ok {
+			word = v
+		}
+		sb.WriteString(word)
+	}
+
+	return sb.String()
+}
+
+// modelName returns a unique model struct name for the specified nested attribute.
+func (g *generator) modelName(name, suffix string) string {
+	base := convert.ToLowercasePrefix(g.goName(name)) + "Model"
+
+	v := base + suffix
+	for i := 2; g.modelNames[v]; i++ {
+		v = fmt.Sprintf("%s%d%s", base, i, suffix)
+	}
+	g.modelNames[v] = true
+
+	return v
+}
+
+// schemaOptions controls the generation of a Plugin Framework schema.
+type schemaOptions struct {
+	current     bool   // Whether to generate the current schema, or a prior schema used only to read state
+	modelSuffix string // Suffix appended to nested model names
+	reuseModels bool   // Whether nested objects use the models of the current schema
+	normalize   bool   // Whether to record how SDKv2 zero values in prior state are converted to null
+}
+
+// resourceSchema returns the Go source of a resource schema.Schema literal and the fields of its model.
+func (g *generator) resourceSchema(attributes []*sdkAttribute, timeouts []sdkTimeout, version int, opts schemaOptions) (string, []fwField) {
+	if !slices.ContainsFunc(attributes, func(a *sdkAttribute) bool { return a.Name == "id" }) {
+		attributes = append(slices.Clone(attributes), &sdkAttribute{
+			Key:      "names.AttrID",
+			Name:     "id",
+			Type:     "String",
+			Computed: true,
+		})
+		slices.SortFunc(attributes, func(a, b *sdkAttribute) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+	}
+
+	attrs, blocks, fields := g.object(attributes, opts, "")
+
+	if len(timeouts) > 0 {
+		var opts, attrTypes strings.Builder
+		for _, t := range timeouts {
+			fmt.Fprintf(&opts, "%s: true,\n", t.Operation)
+			fmt.Fprintf(&attrTypes, "%q: types.StringType,\n", strings.ToLower(t.Operation))
+		}
+
+		blocks = append([]string{fmt.Sprintf("names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{\n%s}),", opts.String())}, blocks...)
+		fields = append(fields, fwField{
+			GoName: "Timeouts",
+			GoType: "timeouts.Value",
+			TFName: "timeouts",
+			Null:   fmt.Sprintf("timeouts.Value{\nObject: types.ObjectNull(map[string]attr.Type{\n%s}),\n}", attrTypes.String()),
+		})
+		slices.SortFunc(fields, func(a, b fwField) int {
+			return strings.Compare(a.GoName, b.GoName)
+		})
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "schema.Schema{\nVersion: %d,\n", version)
+	writeAttributesAndBlocks(&sb, attrs, blocks)
+	sb.WriteString("}")
+
+	return sb.String(), fields
+}
+
+func writeAttributesAndBlocks(sb *strings.Builder, attrs, blocks []string) {
+	if len(attrs) > 0 {
+		sb.WriteString("Attributes: map[string]schema.Attribute{\n")
+		for _, v := range attrs {
+			sb.WriteString(v + "\n")
+		}
+		sb.WriteString("},\n")
+	}
+	if len(blocks) > 0 {
+		sb.WriteString("Blocks: map[string]schema.Block{\n")
+		for _, v := range blocks {
+			sb.WriteString(v + "\n")
+		}
+		sb.WriteString("},\n")
+	}
+}
+
+// object returns the Go source of the attributes and blocks of a schema object, and the fields of its model.
+// path is the path of the object in the schema, empty at the top level.
+func (g *generator) object(attributes []*sdkAttribute, opts schemaOptions, path string) ([]string, []string, []fwField) {
+	var attrs, blocks []string
+	var fields []fwField
+
+	for _, a := range attributes {
+		field := fwField{
+			GoName: g.goName(a.Name),
+			TFName: a.Name,
+		}
+
+		switch {
+		case a.Unsupported != "":
+			switch a.Name {
+			case "tags":
+				attrs = append(attrs, a.Key+": tftags.TagsAttribute(),")
+			case "tags_all":
+				attrs = append(attrs, a.Key+": tftags.TagsAttributeComputedOnly(),")
+			default:
+				attrs = append(attrs, fmt.Sprintf("// TODO: %s: %s,", a.Key, a.Unsupported))
+				continue
+			}
+			field.GoType = "tftags.Map"
+			field.Null = "tftags.NewMapValueNull()"
+
+		case len(a.Nested) > 0:
+			v, isBlock, ok := g.nested(a, opts, path+"/"+a.Name, &field)
+			if isBlock {
+				blocks = append(blocks, v)
+			} else {
+				attrs = append(attrs, v)
+			}
+			if !ok {
+				continue
+			}
+
+		case a.ElemType != "":
+			v, ok := g.collection(a, opts, &field)
+			attrs = append(attrs, v)
+			if !ok {
+				continue
+			}
+
+		default:
+			v, ok := g.primitive(a, opts, &field)
+			attrs = append(attrs, v)
+			if !ok {
+				continue
+			}
+		}
+
+		if path != "" || !opts.normalize || !a.Optional || a.Computed || a.Default != "" {
+			field.Normalize = ""
+			field.Empty = false
+		}
+
+		fields = append(fields, field)
+	}
+
+	slices.SortFunc(fields, func(a, b fwField) int {
+		return strings.Compare(a.GoName, b.GoName)
+	})
+
+	return attrs, blocks, fields
+}
+
+// flags returns the Go source of an attribute's Required, Optional, Computed and Sensitive fields.
+func flags(a *sdkAttribute, current bool) string {
+	var sb strings.Builder
+
+	if a.Required {
+		sb.WriteString("Required: true,\n")
+	}
+	if a.Optional {
+		sb.WriteString("Optional: true,\n")
+	}
+	// Plugin Framework attributes with a default value must be Computed.
+	if a.Computed || (current && a.Default != "") {
+		sb.WriteString("Computed: true,\n")
+	}
+	if a.Sensitive {
+		sb.WriteString("Sensitive: true,\n")
+	}
+
+	return sb.String()
+}
+
+// documentation returns the Go source of an attribute's Description and DeprecationMessage fields, and TODOs for SDKv2 features that must be migrated by hand.
+func documentation(a *sdkAttribute, current bool) string {
+	if !current {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	if a.Description != "" {
+		fmt.Fprintf(&sb, "Description: %s,\n", a.Description)
+	}
+	if a.Deprecated != "" {
+		fmt.Fprintf(&sb, "DeprecationMessage: %s,\n", a.Deprecated)
+	}
+	for _, v := range a.Notes {
+		fmt.Fprintf(&sb, "// TODO: Migrate %s\n", v)
+	}
+
+	return sb.String()
+}
+
+func (g *generator) primitive(a *sdkAttribute, opts schemaOptions, field *fwField) (string, bool) {
+	t, ok := primitiveTypes[a.Type]
+	if !ok {
+		return fmt.Sprintf("// TODO: %s: unsupported Type %q,", a.Key, a.Type), false
+	}
+
+	field.GoType = t.GoType
+	field.Null = t.Null
+	if a.Type == "String" {
+		field.Normalize = "fwflex.EmptyStringAsNull(%[1]s)"
+	}
+
+	computedOnly := a.Computed && !a.Optional && !a.Required
+
+	if computedOnly && a.Type == "String" {
+		switch a.Name {
+		case "arn":
+			return a.Key + ": framework.ARNAttributeComputedOnly(),", true
+		case "id":
+			return a.Key + ": framework.IDAttribute(),", true
+		}
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s: schema.%s{\n", a.Key, t.Attribute)
+	sb.WriteString(documentation(a, opts.current))
+	sb.WriteString(flags(a, opts.current))
+
+	if opts.current {
+		if a.Default != "" {
+			fmt.Fprintf(&sb, "Default: %s.%s(%s),\n", t.Default, t.Static, defaultValue(a))
+		}
+
+		var modifiers []string
+		if a.ForceNew {
+			modifiers = append(modifiers, t.PlanModifier+".RequiresReplace(),")
+		}
+		if computedOnly {
+			modifiers = append(modifiers, t.PlanModifier+".UseStateForUnknown(),")
+		}
+		if len(modifiers) > 0 {
+			fmt.Fprintf(&sb, "PlanModifiers: []planmodifier.%s{\n%s\n},\n", strings.TrimSuffix(t.Attribute, "Attribute"), strings.Join(modifiers, "\n"))
+		}
+	}
+
+	sb.WriteString("},")
+
+	return sb.String(), true
+}
+
+// defaultValue returns the Go source of an attribute's default value, converted to the Plugin Framework value type.
+func defaultValue(a *sdkAttribute) string {
+	var conversion string
+	switch a.Type {
+	case "Float":
+		conversion = "float64"
+	case "Int":
+		conversion = "int64"
+	case "String":
+		conversion = "string"
+	default:
+		return a.Default
+	}
+
+	// Literals are untyped, but constants such as AWS SDK enum values may not be.
+	switch v := a.Default; {
+	case strings.HasPrefix(v, `"`), strings.HasPrefix(v, "`"), strings.HasPrefix(v, conversion+"("):
+		return v
+	case v[0] == '-', v[0] >= '0' && v[0] <= '9':
+		return v
+	default:
+		return conversion + "(" + v + ")"
+	}
+}
+
+// collectionKind describes the Plugin Framework equivalents of an SDKv2 collection type.
+type collectionKind struct {
+	Attribute    string // e.g. "ListAttribute"
+	Block        string // e.g. "ListNestedBlock"
+	PlanModifier string // e.g. "listplanmodifier"
+	Validator    string // e.g. "listvalidator"
+}
+
+var collectionKinds = map[string]collectionKind{
+	"List": {
+		Attribute:    "ListAttribute",
+		Block:        "ListNestedBlock",
+		PlanModifier: "listplanmodifier",
+		Validator:    "listvalidator",
+	},
+	"Map": {
+		Attribute:    "MapAttribute",
+		PlanModifier: "mapplanmodifier",
+		Validator:    "mapvalidator",
+	},
+	"Set": {
+		Attribute:    "SetAttribute",
+		Block:        "SetNestedBlock",
+		PlanModifier: "setplanmodifier",
+		Validator:    "setvalidator",
+	},
+}
+
+// collectionType returns the custom type, Go type and null value of a collection of primitives.
+func collectionType(kind, elemType string) (string, string, string) {
+	elem := primitiveTypes[elemType].GoType
+
+	switch kind + elemType {
+	case "ListString":
+		return "fwtypes.ListOfStringType", "fwtypes.ListOfString", "fwtypes.NewListValueOfNull[types.String](ctx)"
+	case "ListInt":
+		return "fwtypes.ListOfInt64Type", "fwtypes.ListOfInt64", "fwtypes.NewListValueOfNull[types.Int64](ctx)"
+	case "SetString":
+		return "fwtypes.SetOfStringType", "fwtypes.SetOfString", "fwtypes.NewSetValueOfNull[types.String](ctx)"
+	case "SetInt":
+		return "fwtypes.SetOfInt64Type", "fwtypes.SetOfInt64", "fwtypes.NewSetValueOfNull[types.Int64](ctx)"
+	case "MapString":
+		return "fwtypes.MapOfStringType", "fwtypes.MapOfString", "fwtypes.NewMapValueOfNull[types.String](ctx)"
+	}
+
+	switch kind {
+	case "List":
+		return "", "types.List", fmt.Sprintf("types.ListNull(%s)", primitiveTypes[elemType].ElementType)
+	case "Set":
+		return fmt.Sprintf("fwtypes.NewSetTypeOf[%s](ctx)", elem), fmt.Sprintf("fwtypes.SetValueOf[%s]", elem), fmt.Sprintf("fwtypes.NewSetValueOfNull[%s](ctx)", elem)
+	default:
+		return fmt.Sprintf("fwtypes.NewMapTypeOf[%s](ctx)", elem), fmt.Sprintf("fwtypes.MapValueOf[%s]", elem), fmt.Sprintf("fwtypes.NewMapValueOfNull[%s](ctx)", elem)
+	}
+}
+
+func (g *generator) collection(a *sdkAttribute, opts schemaOptions, field *fwField) (string, bool) {
+	k, ok := collectionKinds[a.Type]
+	if !ok {
+		return fmt.Sprintf("// TODO: %s: unsupported Type %q with Elem,", a.Key, a.Type), false
+	}
+
+	customType, goType, null := collectionType(a.Type, a.ElemType)
+
+	field.GoType = goType
+	field.Null = null
+	field.Empty = true
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s: schema.%s{\n", a.Key, k.Attribute)
+	if customType != "" {
+		fmt.Fprintf(&sb, "CustomType: %s,\n", customType)
+	}
+	fmt.Fprintf(&sb, "ElementType: %s,\n", primitiveTypes[a.ElemType].ElementType)
+	sb.WriteString(documentation(a, opts.current))
+	sb.WriteString(flags(a, false))
+
+	if opts.current {
+		if a.Default != "" {
+			fmt.Fprintf(&sb, "// TODO: Migrate Default: %s\n", a.Default)
+		}
+		g.modifiersAndValidators(&sb, a, k)
+	}
+
+	sb.WriteString("},")
+
+	return sb.String(), true
+}
+
+// modifiersAndValidators writes the plan modifiers and size validators of a collection attribute or block.
+func (g *generator) modifiersAndValidators(sb *strings.Builder, a *sdkAttribute, k collectionKind) {
+	kind := strings.TrimSuffix(k.Attribute, "Attribute")
+
+	var modifiers []string
+	if a.ForceNew {
+		modifiers = append(modifiers, k.PlanModifier+".RequiresReplace(),")
+	}
+	if a.Computed && !a.Optional && !a.Required {
+		modifiers = append(modifiers, k.PlanModifier+".UseStateForUnknown(),")
+	}
+	if len(modifiers) > 0 {
+		fmt.Fprintf(sb, "PlanModifiers: []planmodifier.%s{\n%s\n},\n", kind, strings.Join(modifiers, "\n"))
+	}
+
+	var validators []string
+	if a.Required && a.isNestedBlock() {
+		validators = append(validators, k.Validator+".IsRequired(),")
+	}
+	if a.MinItems > 0 {
+		validators = append(validators, fmt.Sprintf("%s.SizeAtLeast(%d),", k.Validator, a.MinItems))
+	}
+	if a.MaxItems > 0 {
+		validators = append(validators, fmt.Sprintf("%s.SizeAtMost(%d),", k.Validator, a.MaxItems))
+	}
+	if len(validators) > 0 {
+		fmt.Fprintf(sb, "Validators: []validator.%s{\n%s\n},\n", kind, strings.Join(validators, "\n"))
+	}
+}
+
+// nested returns the Go source of a nested block, or of an attribute of nested objects, and whether it is a block.
+func (g *generator) nested(a *sdkAttribute, opts schemaOptions, path string, field *fwField) (string, bool, bool) {
+	k, ok := collectionKinds[a.Type]
+	if !ok || k.Block == "" {
+		return fmt.Sprintf("// TODO: %s: unsupported Type %q with nested Elem,", a.Key, a.Type), false, false
+	}
+
+	name, ok := g.modelPaths[path]
+	if !opts.reuseModels || !ok {
+		name = g.modelName(a.Name, opts.modelSuffix)
+		if opts.current {
+			g.modelPaths[path] = name
+		}
+	}
+
+	nestedOpts := opts
+	nestedOpts.normalize = false
+	attrs, blocks, fields := g.object(a.Nested, nestedOpts, path)
+	if name != g.modelPaths[path] || opts.current {
+		g.models = append(g.models, &fwModel{
+			Name:   name,
+			Fields: fields,
+		})
+	}
+
+	field.GoType = fmt.Sprintf("fwtypes.%sNestedObjectValueOf[%s]", a.Type, name)
+	field.Null = fmt.Sprintf("fwtypes.New%sNestedObjectValueOfNull[%s](ctx)", a.Type, name)
+	field.Empty = true
+
+	var sb strings.Builder
+
+	if !a.isNestedBlock() {
+		fmt.Fprintf(&sb, "%s: schema.%s{\n", a.Key, k.Attribute)
+		fmt.Fprintf(&sb, "CustomType: fwtypes.New%sNestedObjectTypeOf[%s](ctx),\n", a.Type, name)
+		fmt.Fprintf(&sb, "ElementType: fwtypes.NewObjectTypeOf[%s](ctx),\n", name)
+		sb.WriteString(documentation(a, opts.current))
+		sb.WriteString(flags(a, false))
+		if opts.current {
+			g.modifiersAndValidators(&sb, a, k)
+		}
+		sb.WriteString("},")
+
+		return sb.String(), false, true
+	}
+
+	fmt.Fprintf(&sb, "%s: schema.%s{\n", a.Key, k.Block)
+	fmt.Fprintf(&sb, "CustomType: fwtypes.New%sNestedObjectTypeOf[%s](ctx),\n", a.Type, name)
+	sb.WriteString(documentation(a, opts.current))
+	if opts.current {
+		if a.Computed {
+			sb.WriteString("// TODO: Plugin Framework blocks cannot be Computed. Consider a nested attribute.\n")
+		}
+		g.modifiersAndValidators(&sb, a, k)
+	}
+	sb.WriteString("NestedObject: schema.NestedBlockObject{\n")
+	writeAttributesAndBlocks(&sb, attrs, blocks)
+	sb.WriteString("},\n},")
+
+	return sb.String(), true, true
+}
+
+// modelSource returns the Go source of a model struct.
+func modelSource(name string, fields []fwField, embedded ...string) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "type %s struct {\n", name)
+	for _, v := range embedded {
+		sb.WriteString(v + "\n")
+	}
+	for _, f := range fields {
+		fmt.Fprintf(&sb, "%s %s `tfsdk:\"%s\"`\n", f.GoName, f.GoType, f.TFName)
+	}
+	sb.WriteString("}")
+
+	return sb.String()
+}
+
+// upgraderSource returns the Go source of a state upgrader function from a prior model to the current model.
+func upgraderSource(funcName, priorModel, priorVar string, prior []fwField, currentModel, currentVar string, current []fwField, todo string) string {
+	priorFields := make(map[string]fwField, len(prior))
+	for _, f := range prior {
+		priorFields[f.TFName] = f
+	}
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "func %s(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {\n", funcName)
+	fmt.Fprintf(&sb, "var %s %s\n", priorVar, priorModel)
+	fmt.Fprintf(&sb, "response.Diagnostics.Append(request.State.Get(ctx, &%s)...)\n", priorVar)
+	sb.WriteString("if response.Diagnostics.HasError() {\nreturn\n}\n\n")
+	if todo != "" {
+		sb.WriteString(todo + "\n")
+	}
+	fmt.Fprintf(&sb, "%s := %s{\n", currentVar, currentModel)
+
+	var empty []fwField
+	for _, f := range current {
+		p, ok := priorFields[f.TFName]
+		switch {
+		case !ok:
+			fmt.Fprintf(&sb, "%s: %s,\n", f.GoName, f.Null)
+		case p.GoType != f.GoType:
+			fmt.Fprintf(&sb, "%s: %s, // TODO: Convert from %s.%s.\n", f.GoName, f.Null, priorVar, p.GoName)
+		case f.Normalize != "":
+			fmt.Fprintf(&sb, "%s: %s,\n", f.GoName, fmt.Sprintf(f.Normalize, priorVar+"."+p.GoName))
+		default:
+			fmt.Fprintf(&sb, "%s: %s.%s,\n", f.GoName, priorVar, p.GoName)
+			if f.Empty {
+				empty = append(empty, f)
+			}
+		}
+	}
+	sb.WriteString("}\n\n")
+
+	for _, f := range empty {
+		fmt.Fprintf(&sb, "if len(%s.%s.Elements()) == 0 {\n%s.%s = %s\n}\n", priorVar, priorFields[f.TFName].GoName, currentVar, f.GoName, f.Null)
+	}
+	if len(empty) > 0 {
+		sb.WriteString("\n")
+	}
+
+	fmt.Fprintf(&sb, "response.Diagnostics.Append(response.State.Set(ctx, %s)...)\n", currentVar)
+	sb.WriteString("}")
+
+	return sb.String()
+}
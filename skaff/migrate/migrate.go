@@ -0,0 +1,454 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+//go:embed migrate.gtpl
+var migrateTmpl string
+
+//go:embed migratestate.gtpl
+var migrateStateTmpl string
+
+//go:embed migratetest.gtpl
+var migrateTestTmpl string
+
+const (
+	// importsMarker is replaced by the imports used by generated Go source.
+	importsMarker = "\t// @imports\n"
+
+	// defaultPreviousVersion is used when the most recently published version of the provider cannot be read from the CHANGELOG.
+	defaultPreviousVersion = "6.0.0"
+)
+
+var (
+	versionRegexp = regexache.MustCompile(`^## ([0-9]+\.[0-9]+\.[0-9]+) \(`)
+)
+
+// frameworkImports are the import specs of packages used by generated Plugin Framework source.
+// They take precedence over the imports of the SDKv2 resource's file.
+var frameworkImports = map[string]string{
+	"attr":                `"github.com/hashicorp/terraform-plugin-framework/attr"`,
+	"booldefault":         `"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"`,
+	"boolplanmodifier":    `"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"`,
+	"context":             `"context"`,
+	"float64default":      `"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"`,
+	"float64planmodifier": `"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"`,
+	"framework":           `"github.com/hashicorp/terraform-provider-aws/internal/framework"`,
+	"fwflex":              `fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"`,
+	"fwtypes":             `fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`,
+	"int64default":        `"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"`,
+	"int64planmodifier":   `"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"`,
+	"listplanmodifier":    `"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"`,
+	"listvalidator":       `"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"`,
+	"mapplanmodifier":     `"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"`,
+	"mapvalidator":        `"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"`,
+	"names":               `"github.com/hashicorp/terraform-provider-aws/names"`,
+	"planmodifier":        `"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"`,
+	"resource":            `"github.com/hashicorp/terraform-plugin-framework/resource"`,
+	"schema":              `"github.com/hashicorp/terraform-plugin-framework/resource/schema"`,
+	"setplanmodifier":     `"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"`,
+	"setvalidator":        `"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"`,
+	"stringdefault":       `"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"`,
+	"stringplanmodifier":  `"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"`,
+	"tftags":              `tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"`,
+	"time":                `"time"`,
+	"timeouts":            `"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"`,
+	"types":               `"github.com/hashicorp/terraform-plugin-framework/types"`,
+	"validator":           `"github.com/hashicorp/terraform-plugin-framework/schema/validator"`,
+}
+
+type TemplateData struct {
+	Resource             string
+	ResourceLowerCamel   string
+	IncludeComments      bool
+	HumanFriendlyService string
+	ServicePackage       string
+	Service              string
+	ProviderResourceName string
+	HumanResourceName    string
+	Annotations          []string
+	SDKFilename          string
+	FileBase             string
+	SDKFunc              string
+	SDKFuncExport        string // Name of the test-only export of SDKFunc in exports_test.go
+	CRUD                 map[string]string
+	Importer             bool
+	ImportByIdentity     bool
+	Timeouts             []sdkTimeout
+	SchemaVersion        int
+	Schema               string
+	Models               []string
+	PriorSchemas         []PriorSchema
+	UnreadUpgraders      []sdkStateUpgrader
+	PreviousVersion      string
+}
+
+// PriorSchema is a prior version of the resource schema and its state upgrader.
+type PriorSchema struct {
+	Version      int
+	SchemaFunc   string
+	Schema       string
+	Models       []string
+	UpgraderFunc string
+	Upgrader     string
+	SDKUpgraders []string // SDKv2 state upgraders whose changes the upgrader must also make
+}
+
+func Create(resourceName, typeName string, comments, force bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if resourceName == "" {
+		return fmt.Errorf("error checking: no name given")
+	}
+
+	if resourceName == strings.ToLower(resourceName) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., KeyPair)")
+	}
+
+	if typeName != "" && typeName != strings.ToLower(typeName) {
+		return fmt.Errorf("error checking: type name should be all lower case with underscores (e.g., aws_key_pair)")
+	}
+
+	service, err := data.LookupService(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
+	}
+
+	namesDir := filepath.Join("..", "..", "..", "names")
+
+	goNames, err := readCSV(filepath.Join(namesDir, "attr_constants.csv"), false)
+	if err != nil {
+		return err
+	}
+
+	attrNames := make(map[string]string, len(goNames))
+	for k, v := range goNames {
+		attrNames["Attr"+v] = k
+	}
+
+	caps, err := readCSV(filepath.Join(namesDir, "caps.csv"), true)
+	if err != nil {
+		return err
+	}
+	caps["Id"] = "ID"
+	caps["Ids"] = "IDs"
+	caps["Arns"] = "ARNs"
+
+	p := newSDKParser(attrNames)
+
+	filenames, err := filepath.Glob("*.go")
+	if err != nil {
+		return fmt.Errorf("error listing Go source files: %w", err)
+	}
+
+	for _, filename := range filenames {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+
+		if err := p.addFile(filename, nil); err != nil {
+			return err
+		}
+	}
+
+	r, err := p.resource("resource"+resourceName, typeName)
+	if err != nil {
+		return fmt.Errorf("error reading SDKv2 resource: %w", err)
+	}
+
+	templateData := newTemplateData(r, resourceName, newGenerator(goNames, caps))
+	templateData.HumanFriendlyService = service.HumanFriendly()
+	templateData.IncludeComments = comments
+	templateData.PreviousVersion = previousVersion(filepath.Join("..", "..", "..", "CHANGELOG.md"))
+	templateData.Service = service.ProviderNameUpper()
+	templateData.ServicePackage = servicePackage
+
+	base := templateData.FileBase
+
+	f := fmt.Sprintf("%s_framework.go", base)
+	if err = writeTemplate("migrate", f, migrateTmpl, force, templateData, r.Imports); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	sf := fmt.Sprintf("%s_framework_migrate.go", base)
+	if err = writeTemplate("migratestate", sf, migrateStateTmpl, force, templateData, r.Imports); err != nil {
+		return fmt.Errorf("writing resource state upgrade template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_framework_migrate_test.go", base)
+	if err = writeTemplate("migratetest", tf, migrateTestTmpl, force, templateData, nil); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	return nil
+}
+
+// newTemplateData generates the Plugin Framework schemas, models and state upgraders of an SDKv2 resource.
+func newTemplateData(r *sdkResource, resourceName string, g *generator) TemplateData {
+	lowerCamel := convert.ToLowercasePrefix(resourceName)
+	version := r.SchemaVersion + 1
+
+	td := TemplateData{
+		Resource:             resourceName,
+		ResourceLowerCamel:   lowerCamel,
+		ProviderResourceName: r.TypeName,
+		HumanResourceName:    r.Name,
+		SDKFilename:          filepath.Base(r.Filename),
+		FileBase:             strings.TrimSuffix(filepath.Base(r.Filename), ".go"),
+		SDKFunc:              r.FuncName,
+		SDKFuncExport:        strings.ToUpper(r.FuncName[:1]) + r.FuncName[1:],
+		CRUD:                 r.CRUD,
+		Importer:             r.Importer,
+		Timeouts:             r.Timeouts,
+		SchemaVersion:        version,
+	}
+
+	if td.HumanResourceName == "" {
+		td.HumanResourceName = convert.ToHumanResName(resourceName)
+	}
+
+	for _, v := range r.Annotations {
+		if strings.HasPrefix(v, "@ArnIdentity") || strings.HasPrefix(v, "@IdentityAttribute") || strings.HasPrefix(v, "@SingletonIdentity") {
+			td.ImportByIdentity = true
+		}
+		td.Annotations = append(td.Annotations, v)
+	}
+
+	model := lowerCamel + "ResourceModel"
+	schema, fields := g.resourceSchema(r.Attributes, r.Timeouts, version, schemaOptions{current: true, normalize: true})
+	td.Schema = schema
+	td.Models = append(td.Models, modelSource(model, fields, "framework.WithRegionModel"))
+	for _, m := range g.takeModels() {
+		td.Models = append(td.Models, modelSource(m.Name, m.Fields))
+	}
+
+	// The current SDKv2 schema is the most recent prior schema.
+	upgraders := slices.Clone(r.StateUpgraders)
+	upgraders = slices.DeleteFunc(upgraders, func(u sdkStateUpgrader) bool {
+		return u.Version >= r.SchemaVersion
+	})
+	upgraders = append(upgraders, sdkStateUpgrader{
+		Version:    r.SchemaVersion,
+		Attributes: r.Attributes,
+		Timeouts:   r.Timeouts,
+	})
+
+	for _, u := range upgraders {
+		if u.Attributes == nil {
+			td.UnreadUpgraders = append(td.UnreadUpgraders, u)
+			continue
+		}
+
+		var opts schemaOptions
+		if u.Version == r.SchemaVersion {
+			opts.reuseModels = true
+		} else {
+			opts.modelSuffix = fmt.Sprintf("V%d", u.Version)
+		}
+
+		prior := PriorSchema{
+			Version:      u.Version,
+			SchemaFunc:   fmt.Sprintf("%sResourceSchemaV%d", lowerCamel, u.Version),
+			UpgraderFunc: fmt.Sprintf("upgrade%sResourceStateV%dtoV%d", resourceName, u.Version, version),
+		}
+
+		for _, v := range r.StateUpgraders {
+			if v.Version >= u.Version && v.Upgrade != "" {
+				prior.SDKUpgraders = append(prior.SDKUpgraders, v.Upgrade)
+			}
+		}
+
+		priorModel := fmt.Sprintf("%sResourceModelV%d", lowerCamel, u.Version)
+		schema, priorFields := g.resourceSchema(u.Attributes, u.Timeouts, u.Version, opts)
+		prior.Schema = schema
+		prior.Models = append(prior.Models, modelSource(priorModel, priorFields))
+		for _, m := range g.takeModels() {
+			prior.Models = append(prior.Models, modelSource(m.Name, m.Fields))
+		}
+
+		var todo string
+		if len(prior.SDKUpgraders) > 0 {
+			todo = fmt.Sprintf("// TODO: Make the changes made by the SDKv2 state upgraders %s.", strings.Join(prior.SDKUpgraders, ", "))
+		}
+		prior.Upgrader = upgraderSource(prior.UpgraderFunc,
+			priorModel, fmt.Sprintf("%sDataV%d", lowerCamel, u.Version), priorFields,
+			model, fmt.Sprintf("%sDataV%d", lowerCamel, version), fields,
+			todo)
+
+		td.PriorSchemas = append(td.PriorSchemas, prior)
+	}
+
+	return td
+}
+
+// readCSV reads a two-column CSV file into a map.
+func readCSV(filename string, header bool) (map[string]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error opening file (%s): %s", filename, err)
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("error reading CSV file (%s): %s", filename, err)
+	}
+
+	if header && len(records) > 0 {
+		records = records[1:]
+	}
+
+	m := make(map[string]string, len(records))
+	for _, record := range records {
+		if len(record) >= 2 {
+			m[record[0]] = record[1]
+		}
+	}
+
+	return m, nil
+}
+
+// previousVersion returns the most recently published version of the provider from the CHANGELOG.
+func previousVersion(filename string) string {
+	f, err := os.Open(filename)
+	if err != nil {
+		return defaultPreviousVersion
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.Contains(line, "(Unreleased)") {
+			continue
+		}
+		if m := versionRegexp.FindStringSubmatch(line); m != nil {
+			return m[1]
+		}
+	}
+
+	return defaultPreviousVersion
+}
+
+// addImports replaces the imports marker in Go source with the imports of the packages it uses.
+// Packages are looked up in frameworkImports and then in the imports of the SDKv2 resource's file.
+func addImports(src []byte, sdkImports map[string]string) ([]byte, error) {
+	if !bytes.Contains(src, []byte(importsMarker)) {
+		return src, nil
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(map[string]string)
+	ast.Inspect(file, func(n ast.Node) bool {
+		if v, ok := n.(*ast.SelectorExpr); ok {
+			// Package names are not resolved by the parser.
+			if x, ok := v.X.(*ast.Ident); ok && x.Obj == nil {
+				if spec, ok := frameworkImports[x.Name]; ok {
+					used[x.Name] = spec
+				} else if spec, ok := sdkImports[x.Name]; ok {
+					used[x.Name] = spec
+				}
+			}
+		}
+		return true
+	})
+
+	var std, other []string
+	for _, spec := range used {
+		path := spec[strings.Index(spec, `"`):]
+		if strings.Contains(strings.SplitN(path, "/", 2)[0], ".") {
+			other = append(other, spec)
+		} else {
+			std = append(std, spec)
+		}
+	}
+
+	importPath := func(spec string) string {
+		return spec[strings.Index(spec, `"`):]
+	}
+	sortByPath := func(a, b string) int {
+		return strings.Compare(importPath(a), importPath(b))
+	}
+	slices.SortFunc(std, sortByPath)
+	slices.SortFunc(other, sortByPath)
+
+	var imports strings.Builder
+	for _, v := range std {
+		fmt.Fprintf(&imports, "\t%s\n", v)
+	}
+	if len(std) > 0 && len(other) > 0 {
+		imports.WriteString("\n")
+	}
+	for _, v := range other {
+		fmt.Fprintf(&imports, "\t%s\n", v)
+	}
+
+	return bytes.Replace(src, []byte(importsMarker), []byte(imports.String()), 1), nil
+}
+
+func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData, sdkImports map[string]string) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	src, err := addImports(buffer.Bytes(), sdkImports)
+	if err != nil {
+		return fmt.Errorf("error adding imports to generated source (%s): %s", filename, err)
+	}
+
+	formatted, err := format.Source(src)
+	if err != nil {
+		// Write the unformatted source so that it can be fixed by hand.
+		formatted = src
+		err = fmt.Errorf("error formatting generated source (%s): %s", filename, err)
+	}
+
+	if err := os.WriteFile(filename, formatted, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return err
+}
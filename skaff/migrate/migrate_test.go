@@ -0,0 +1,265 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testSDKResource = `package example
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_example_widget", name="Widget")
+// @Tags(identifierAttribute="arn")
+func resourceWidget() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWidgetCreate,
+		ReadWithoutTimeout:   resourceWidgetRead,
+		DeleteWithoutTimeout: resourceWidgetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceWidgetV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: widgetStateUpgradeV0,
+				Version: 0,
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"color": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"red", "blue"}, false),
+			},
+			"part": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"size": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"secret": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceWidgetV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"colour": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceWidgetCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return nil
+}
+`
+
+func TestParseResource(t *testing.T) {
+	t.Parallel()
+
+	p := newSDKParser(map[string]string{
+		"AttrARN":     "arn",
+		"AttrTags":    "tags",
+		"AttrTagsAll": "tags_all",
+	})
+	if err := p.addFile("widget.go", testSDKResource); err != nil {
+		t.Fatalf("adding file: %s", err)
+	}
+
+	r, err := p.resource("resourceWidget", "")
+	if err != nil {
+		t.Fatalf("parsing resource: %s", err)
+	}
+
+	if got, want := r.TypeName, "aws_example_widget"; got != want {
+		t.Errorf("TypeName: got %s, expected %s", got, want)
+	}
+	if got, want := r.Name, "Widget"; got != want {
+		t.Errorf("Name: got %s, expected %s", got, want)
+	}
+	if got, want := r.SchemaVersion, 1; got != want {
+		t.Errorf("SchemaVersion: got %d, expected %d", got, want)
+	}
+	if got, want := r.CRUD["Create"], "resourceWidgetCreate"; got != want {
+		t.Errorf("CRUD[Create]: got %s, expected %s", got, want)
+	}
+	if !r.Importer {
+		t.Error("Importer: got false, expected true")
+	}
+	if got, want := len(r.Timeouts), 1; got != want {
+		t.Fatalf("Timeouts: got %d, expected %d", got, want)
+	}
+	if got, want := r.Timeouts[0].Default, "10 * time.Minute"; got != want {
+		t.Errorf("Timeouts[0].Default: got %s, expected %s", got, want)
+	}
+
+	var names []string
+	attributes := make(map[string]*sdkAttribute)
+	for _, a := range r.Attributes {
+		names = append(names, a.Name)
+		attributes[a.Name] = a
+	}
+	if got, want := strings.Join(names, ","), "arn,color,part,secret,tags,tags_all"; got != want {
+		t.Errorf("attributes: got %s, expected %s", got, want)
+	}
+	if a := attributes["color"]; !a.Optional || !a.ForceNew || len(a.Notes) == 0 {
+		t.Errorf("color: got %+v, expected Optional, ForceNew and a note for ValidateFunc", a)
+	}
+	if a := attributes["part"]; !a.isNestedBlock() || a.MaxItems != 1 || len(a.Nested) != 1 {
+		t.Errorf("part: got %+v, expected a nested block with MaxItems 1", a)
+	}
+	if a := attributes["secret"]; !a.Sensitive {
+		t.Errorf("secret: got %+v, expected Sensitive", a)
+	}
+
+	if got, want := len(r.StateUpgraders), 1; got != want {
+		t.Fatalf("StateUpgraders: got %d, expected %d", got, want)
+	}
+	if u := r.StateUpgraders[0]; u.Upgrade != "widgetStateUpgradeV0" || len(u.Attributes) != 1 || u.Attributes[0].Name != "colour" {
+		t.Errorf("StateUpgraders[0]: got %+v, expected the V0 schema", u)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	p := newSDKParser(map[string]string{
+		"AttrARN":     "arn",
+		"AttrTags":    "tags",
+		"AttrTagsAll": "tags_all",
+	})
+	if err := p.addFile("widget.go", testSDKResource); err != nil {
+		t.Fatalf("adding file: %s", err)
+	}
+
+	r, err := p.resource("", "aws_example_widget")
+	if err != nil {
+		t.Fatalf("parsing resource: %s", err)
+	}
+
+	td := newTemplateData(r, "Widget", newGenerator(map[string]string{"arn": "ARN"}, nil))
+	td.ServicePackage = "example"
+	td.Service = "Example"
+	td.PreviousVersion = defaultPreviousVersion
+
+	if got, want := td.SchemaVersion, 2; got != want {
+		t.Errorf("SchemaVersion: got %d, expected %d", got, want)
+	}
+	if got, want := len(td.PriorSchemas), 2; got != want {
+		t.Fatalf("PriorSchemas: got %d, expected %d", got, want)
+	}
+
+	dir := t.TempDir()
+	testCases := []struct {
+		filename string
+		tmpl     string
+		expected []string
+	}{
+		{
+			filename: "widget_framework.go",
+			tmpl:     migrateTmpl,
+			expected: []string{
+				`// @FrameworkResource("aws_example_widget", name="Widget")`,
+				`// @Tags(identifierAttribute="arn")`,
+				`Version: 2,`,
+				`framework.WithImportByID`,
+				`framework.WithNoUpdate`,
+				`r.SetDefaultCreateTimeout(10 * time.Minute)`,
+				`names.AttrARN: framework.ARNAttributeComputedOnly(),`,
+				`Sensitive: true,`,
+				`listvalidator.SizeAtMost(1),`,
+				`fwtypes.ListNestedObjectValueOf[partModel]`,
+				`"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"`,
+			},
+		},
+		{
+			filename: "widget_framework_migrate.go",
+			tmpl:     migrateStateTmpl,
+			expected: []string{
+				`func widgetResourceSchemaV0(ctx context.Context) schema.Schema {`,
+				`func widgetResourceSchemaV1(ctx context.Context) schema.Schema {`,
+				`func upgradeWidgetResourceStateV0toV2(`,
+				`func upgradeWidgetResourceStateV1toV2(`,
+				`widgetStateUpgradeV0`,
+				`fwflex.EmptyStringAsNull(`,
+			},
+		},
+		{
+			filename: "widget_framework_migrate_test.go",
+			tmpl:     migrateTestTmpl,
+			expected: []string{
+				`func TestAccExampleWidget_migrateFromPluginSDK(t *testing.T) {`,
+				`VersionConstraint: "` + defaultPreviousVersion + `",`,
+				`acctest.CheckResourceSchemaCompatibility(ctx, t, "aws_example_widget", tfexample.ResourceWidget())`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.filename, func(t *testing.T) {
+			filename := filepath.Join(dir, testCase.filename)
+			if err := writeTemplate(testCase.filename, filename, testCase.tmpl, false, td, r.Imports); err != nil {
+				t.Fatalf("writing template: %s", err)
+			}
+
+			b, err := os.ReadFile(filename)
+			if err != nil {
+				t.Fatalf("reading file: %s", err)
+			}
+
+			src := string(b)
+			for _, v := range testCase.expected {
+				if !strings.Contains(src, v) {
+					t.Errorf("expected generated source to contain %q\n%s", v, src)
+				}
+			}
+		})
+	}
+}
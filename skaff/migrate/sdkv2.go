@@ -0,0 +1,654 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+var (
+	sdkResourceAnnotationRegexp = regexache.MustCompile(`^@SDKResource\("([a-z0-9_]+)"(?:,\s*name="([^"]*)")?\)`)
+)
+
+// sdkResource is the definition of a Terraform Plugin SDKv2 resource, read from its source.
+type sdkResource struct {
+	Filename       string
+	FuncName       string
+	TypeName       string
+	Name           string
+	Annotations    []string // Annotations other than @SDKResource, without the leading "// "
+	SchemaVersion  int
+	StateUpgraders []sdkStateUpgrader
+	CRUD           map[string]string // e.g. "Create" -> "resourceKeyPairCreate"
+	Importer       bool
+	Timeouts       []sdkTimeout
+	Attributes     []*sdkAttribute
+	Imports        map[string]string // Package name to import spec of the file defining the resource
+}
+
+type sdkStateUpgrader struct {
+	Version    int
+	Upgrade    string
+	Attributes []*sdkAttribute // Prior schema, nil if it could not be read
+	Timeouts   []sdkTimeout
+}
+
+type sdkTimeout struct {
+	Operation string // "Create", "Read", "Update" or "Delete"
+	Default   string // e.g. "10 * time.Minute"
+}
+
+// sdkAttribute is an attribute or block of a Terraform Plugin SDKv2 resource schema.
+type sdkAttribute struct {
+	Key         string // Go source of the schema map key, e.g. `names.AttrName` or `"visibility"`
+	Name        string
+	Type        string // "Bool", "Int", "Float", "String", "List", "Set" or "Map"
+	ElemType    string // Element type of primitive List, Set and Map attributes
+	Nested      []*sdkAttribute
+	Required    bool
+	Optional    bool
+	Computed    bool
+	Sensitive   bool
+	ForceNew    bool
+	ConfigAttr  bool // ConfigMode is schema.SchemaConfigModeAttr
+	MaxItems    int
+	MinItems    int
+	Default     string // Go source of the default value
+	Description string // Go source of the description
+	Deprecated  string // Go source of the deprecation message
+	Unsupported string // Go source of an attribute definition that could not be read
+	Notes       []string
+}
+
+// isNestedBlock returns whether the attribute is represented as a block in the Terraform protocol schema.
+func (a *sdkAttribute) isNestedBlock() bool {
+	if len(a.Nested) == 0 || a.ConfigAttr {
+		return false
+	}
+
+	// Computed-only nested resources are represented as attributes.
+	return a.Required || a.Optional
+}
+
+// sdkParser reads SDKv2 resource definitions from the source files of a service package.
+type sdkParser struct {
+	fset      *token.FileSet
+	files     []*ast.File
+	imports   map[string]map[string]string // Filename to package name to import spec
+	funcs     map[string]*ast.FuncDecl
+	consts    map[string]string
+	attrNames map[string]string // names.Attr constant name, e.g. "AttrARN", to attribute name
+}
+
+func newSDKParser(attrNames map[string]string) *sdkParser {
+	return &sdkParser{
+		fset:      token.NewFileSet(),
+		imports:   make(map[string]map[string]string),
+		funcs:     make(map[string]*ast.FuncDecl),
+		consts:    make(map[string]string),
+		attrNames: attrNames,
+	}
+}
+
+func (p *sdkParser) addFile(filename string, src any) error {
+	file, err := parser.ParseFile(p.fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", filename, err)
+	}
+
+	p.files = append(p.files, file)
+
+	imports := make(map[string]string)
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		name := path[strings.LastIndex(path, "/")+1:]
+		if regexache.MustCompile(`^v[0-9]+$`).MatchString(name) {
+			name = strings.TrimSuffix(path, "/"+name)
+			name = name[strings.LastIndex(name, "/")+1:]
+		}
+		name = strings.TrimPrefix(name, "go-")
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = p.source(spec)
+	}
+	p.imports[filename] = imports
+
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv == nil {
+				p.funcs[decl.Name.Name] = decl
+			}
+		case *ast.GenDecl:
+			if decl.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				spec := spec.(*ast.ValueSpec)
+				for i, name := range spec.Names {
+					if i >= len(spec.Values) {
+						continue
+					}
+					if v, ok := spec.Values[i].(*ast.BasicLit); ok && v.Kind == token.STRING {
+						if s, err := strconv.Unquote(v.Value); err == nil {
+							p.consts[name.Name] = s
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// resource returns the SDKv2 resource registered by the function with the specified name,
+// or with the specified Terraform type name.
+func (p *sdkParser) resource(funcName, typeName string) (*sdkResource, error) {
+	type candidate struct {
+		fn          *ast.FuncDecl
+		typeName    string
+		name        string
+		annotations []string
+	}
+	var candidates []candidate
+
+	for _, file := range p.files {
+		for _, decl := range file.Decls {
+			decl, ok := decl.(*ast.FuncDecl)
+			if !ok || decl.Doc == nil {
+				continue
+			}
+
+			var c candidate
+			for _, line := range decl.Doc.List {
+				text := strings.TrimSpace(strings.TrimPrefix(line.Text, "//"))
+				if !strings.HasPrefix(text, "@") {
+					continue
+				}
+				if m := sdkResourceAnnotationRegexp.FindStringSubmatch(text); m != nil {
+					c.fn, c.typeName, c.name = decl, m[1], m[2]
+					continue
+				}
+				c.annotations = append(c.annotations, text)
+			}
+			if c.fn == nil {
+				continue
+			}
+
+			if c.fn.Name.Name == funcName {
+				return p.parseResource(c.fn, c.typeName, c.name, c.annotations)
+			}
+			if c.typeName == typeName {
+				candidates = append(candidates, c)
+			}
+		}
+	}
+
+	if len(candidates) != 1 {
+		return nil, fmt.Errorf("no @SDKResource annotated function %s or resource type %s found", funcName, typeName)
+	}
+
+	c := candidates[0]
+	return p.parseResource(c.fn, c.typeName, c.name, c.annotations)
+}
+
+func (p *sdkParser) parseResource(fn *ast.FuncDecl, typeName, name string, annotations []string) (*sdkResource, error) {
+	lit := findCompositeLit(fn.Body, "schema", "Resource")
+	if lit == nil {
+		return nil, fmt.Errorf("%s: no schema.Resource literal found", fn.Name.Name)
+	}
+
+	r, err := p.resourceLit(lit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fn.Name.Name, err)
+	}
+
+	r.Filename = p.fset.Position(fn.Pos()).Filename
+	r.Imports = p.imports[r.Filename]
+	r.FuncName = fn.Name.Name
+	r.TypeName = typeName
+	r.Name = name
+	r.Annotations = annotations
+
+	return r, nil
+}
+
+// resourceLit reads a schema.Resource literal.
+func (p *sdkParser) resourceLit(lit *ast.CompositeLit) (*sdkResource, error) {
+	r := &sdkResource{
+		CRUD: make(map[string]string),
+	}
+
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+
+		switch field := keyName(kv.Key); field {
+		case "SchemaVersion":
+			v, err := intValue(kv.Value)
+			if err != nil {
+				return nil, fmt.Errorf("SchemaVersion: %w", err)
+			}
+			r.SchemaVersion = v
+
+		case "Schema", "SchemaFunc":
+			m, err := p.schemaMap(kv.Value)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", field, err)
+			}
+			attributes, err := p.attributes(m)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", field, err)
+			}
+			r.Attributes = attributes
+
+		case "StateUpgraders":
+			upgraders := compositeLit(kv.Value)
+			if upgraders == nil {
+				return nil, fmt.Errorf("StateUpgraders: unsupported expression %s", p.source(kv.Value))
+			}
+			for _, elt := range upgraders.Elts {
+				upgrader := compositeLit(elt)
+				if upgrader == nil {
+					continue
+				}
+				var u sdkStateUpgrader
+				for _, elt := range upgrader.Elts {
+					kv, ok := elt.(*ast.KeyValueExpr)
+					if !ok {
+						continue
+					}
+					switch keyName(kv.Key) {
+					case "Version":
+						v, err := intValue(kv.Value)
+						if err != nil {
+							return nil, fmt.Errorf("StateUpgraders: Version: %w", err)
+						}
+						u.Version = v
+					case "Type":
+						// e.g. resourceKeyPairV0().CoreConfigSchema().ImpliedType()
+						if prior := p.priorResource(kv.Value); prior != nil {
+							u.Attributes = prior.Attributes
+							u.Timeouts = prior.Timeouts
+						}
+					case "Upgrade":
+						u.Upgrade = p.source(kv.Value)
+					}
+				}
+				r.StateUpgraders = append(r.StateUpgraders, u)
+			}
+
+		case "Create", "CreateContext", "CreateWithoutTimeout",
+			"Read", "ReadContext", "ReadWithoutTimeout",
+			"Update", "UpdateContext", "UpdateWithoutTimeout",
+			"Delete", "DeleteContext", "DeleteWithoutTimeout":
+			operation := strings.TrimSuffix(strings.TrimSuffix(field, "WithoutTimeout"), "Context")
+			r.CRUD[operation] = p.source(kv.Value)
+
+		case "Importer":
+			r.Importer = true
+
+		case "Timeouts":
+			timeouts := compositeLit(kv.Value)
+			if timeouts == nil {
+				continue
+			}
+			for _, elt := range timeouts.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+				t := sdkTimeout{Operation: keyName(kv.Key)}
+				// schema.DefaultTimeout(10 * time.Minute)
+				if call, ok := kv.Value.(*ast.CallExpr); ok && len(call.Args) == 1 {
+					t.Default = p.source(call.Args[0])
+				}
+				r.Timeouts = append(r.Timeouts, t)
+			}
+		}
+	}
+
+	if r.Attributes == nil {
+		return nil, fmt.Errorf("no Schema or SchemaFunc found")
+	}
+
+	slices.SortFunc(r.StateUpgraders, func(a, b sdkStateUpgrader) int {
+		return a.Version - b.Version
+	})
+
+	return r, nil
+}
+
+// priorResource returns the resource whose implied type is the specified expression,
+// or nil if the expression is not of the form f().CoreConfigSchema().ImpliedType().
+func (p *sdkParser) priorResource(expr ast.Expr) *sdkResource {
+	for {
+		call, ok := expr.(*ast.CallExpr)
+		if !ok {
+			return nil
+		}
+
+		switch fun := call.Fun.(type) {
+		case *ast.SelectorExpr:
+			expr = fun.X
+			continue
+		case *ast.Ident:
+			fn, ok := p.funcs[fun.Name]
+			if !ok {
+				return nil
+			}
+			lit := findCompositeLit(fn.Body, "schema", "Resource")
+			if lit == nil {
+				return nil
+			}
+			r, err := p.resourceLit(lit)
+			if err != nil {
+				return nil
+			}
+			return r
+		default:
+			return nil
+		}
+	}
+}
+
+// schemaMap returns the map[string]*schema.Schema literal defined by the specified expression.
+func (p *sdkParser) schemaMap(expr ast.Expr) (*ast.CompositeLit, error) {
+	switch expr := expr.(type) {
+	case *ast.CompositeLit:
+		return expr, nil
+	case *ast.FuncLit:
+		return p.returnedCompositeLit(expr.Body, expr)
+	case *ast.Ident:
+		if fn, ok := p.funcs[expr.Name]; ok {
+			return p.returnedCompositeLit(fn.Body, expr)
+		}
+	case *ast.CallExpr:
+		if ident, ok := expr.Fun.(*ast.Ident); ok && len(expr.Args) == 0 {
+			return p.schemaMap(ident)
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported schema expression %s", p.source(expr))
+}
+
+func (p *sdkParser) returnedCompositeLit(body *ast.BlockStmt, expr ast.Expr) (*ast.CompositeLit, error) {
+	if body != nil {
+		for _, stmt := range body.List {
+			if ret, ok := stmt.(*ast.ReturnStmt); ok && len(ret.Results) == 1 {
+				if lit := compositeLit(ret.Results[0]); lit != nil {
+					return lit, nil
+				}
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported schema expression %s: no returned map literal", p.source(expr))
+}
+
+func (p *sdkParser) attributes(m *ast.CompositeLit) ([]*sdkAttribute, error) {
+	var attributes []*sdkAttribute
+
+	for _, elt := range m.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return nil, fmt.Errorf("unsupported schema map element %s", p.source(elt))
+		}
+
+		name, err := p.attributeName(kv.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		a := &sdkAttribute{
+			Key:  p.source(kv.Key),
+			Name: name,
+		}
+
+		lit := compositeLit(kv.Value)
+		if lit == nil {
+			a.Unsupported = p.source(kv.Value)
+			attributes = append(attributes, a)
+			continue
+		}
+
+		if err := p.parseAttribute(a, lit); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		attributes = append(attributes, a)
+	}
+
+	slices.SortFunc(attributes, func(a, b *sdkAttribute) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return attributes, nil
+}
+
+func (p *sdkParser) parseAttribute(a *sdkAttribute, lit *ast.CompositeLit) error {
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+
+		switch field := keyName(kv.Key); field {
+		case "Type":
+			a.Type = schemaValueType(kv.Value)
+			if a.Type == "" {
+				return fmt.Errorf("unsupported Type %s", p.source(kv.Value))
+			}
+		case "Required":
+			a.Required = isTrue(kv.Value)
+		case "Optional":
+			a.Optional = isTrue(kv.Value)
+		case "Computed":
+			a.Computed = isTrue(kv.Value)
+		case "Sensitive":
+			a.Sensitive = isTrue(kv.Value)
+		case "ForceNew":
+			a.ForceNew = isTrue(kv.Value)
+		case "MaxItems":
+			v, err := intValue(kv.Value)
+			if err != nil {
+				return fmt.Errorf("MaxItems: %w", err)
+			}
+			a.MaxItems = v
+		case "MinItems":
+			v, err := intValue(kv.Value)
+			if err != nil {
+				return fmt.Errorf("MinItems: %w", err)
+			}
+			a.MinItems = v
+		case "Default":
+			a.Default = p.source(kv.Value)
+		case "ConfigMode":
+			a.ConfigAttr = p.source(kv.Value) == "schema.SchemaConfigModeAttr"
+		case "Elem":
+			elem := compositeLit(kv.Value)
+			if elem == nil {
+				a.Notes = append(a.Notes, "Elem: "+p.source(kv.Value))
+				continue
+			}
+			switch typeName(elem.Type) {
+			case "schema.Schema":
+				for _, elt := range elem.Elts {
+					if kv, ok := elt.(*ast.KeyValueExpr); ok && keyName(kv.Key) == "Type" {
+						a.ElemType = schemaValueType(kv.Value)
+					}
+				}
+				if a.ElemType == "" || a.ElemType == "List" || a.ElemType == "Set" || a.ElemType == "Map" {
+					a.Notes = append(a.Notes, "Elem: "+p.source(kv.Value))
+					a.ElemType = ""
+				}
+			case "schema.Resource":
+				var nested *ast.CompositeLit
+				for _, elt := range elem.Elts {
+					if kv, ok := elt.(*ast.KeyValueExpr); ok && (keyName(kv.Key) == "Schema" || keyName(kv.Key) == "SchemaFunc") {
+						m, err := p.schemaMap(kv.Value)
+						if err != nil {
+							return fmt.Errorf("Elem: %w", err)
+						}
+						nested = m
+					}
+				}
+				if nested == nil {
+					return fmt.Errorf("Elem: no Schema found")
+				}
+				attributes, err := p.attributes(nested)
+				if err != nil {
+					return err
+				}
+				a.Nested = attributes
+			default:
+				a.Notes = append(a.Notes, "Elem: "+p.source(kv.Value))
+			}
+		case "Description":
+			a.Description = p.source(kv.Value)
+		case "Deprecated":
+			a.Deprecated = p.source(kv.Value)
+		case "ValidateFunc", "ValidateDiagFunc", "DiffSuppressFunc", "DiffSuppressOnRefresh", "StateFunc", "Set",
+			"ConflictsWith", "ExactlyOneOf", "AtLeastOneOf", "RequiredWith", "DefaultFunc":
+			a.Notes = append(a.Notes, field+": "+p.source(kv.Value))
+		}
+	}
+
+	if a.Type == "Map" && a.ElemType == "" && len(a.Notes) == 0 {
+		a.ElemType = "String"
+	}
+
+	return nil
+}
+
+// attributeName returns the attribute name defined by the specified schema map key.
+func (p *sdkParser) attributeName(key ast.Expr) (string, error) {
+	switch key := key.(type) {
+	case *ast.BasicLit:
+		if key.Kind == token.STRING {
+			return strconv.Unquote(key.Value)
+		}
+	case *ast.Ident:
+		if v, ok := p.consts[key.Name]; ok {
+			return v, nil
+		}
+	case *ast.SelectorExpr:
+		if x, ok := key.X.(*ast.Ident); ok && x.Name == "names" {
+			if v, ok := p.attrNames[key.Sel.Name]; ok {
+				return v, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("unsupported schema map key %s", p.source(key))
+}
+
+// source returns the Go source of the specified node on a single line.
+func (p *sdkParser) source(node ast.Node) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, p.fset, node); err != nil {
+		return fmt.Sprintf("%T", node)
+	}
+
+	lines := strings.Split(buf.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+
+	s := strings.Join(lines, " ")
+	s = strings.ReplaceAll(s, "{ ", "{")
+	s = strings.ReplaceAll(s, ", }", "}")
+
+	return s
+}
+
+// findCompositeLit returns the first composite literal of the specified qualified type in node.
+func findCompositeLit(node ast.Node, pkg, name string) *ast.CompositeLit {
+	var lit *ast.CompositeLit
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		if lit != nil {
+			return false
+		}
+		if v, ok := n.(*ast.CompositeLit); ok && typeName(v.Type) == pkg+"."+name {
+			lit = v
+			return false
+		}
+		return true
+	})
+
+	return lit
+}
+
+// compositeLit returns the composite literal, or the address of a composite literal, expression.
+func compositeLit(expr ast.Expr) *ast.CompositeLit {
+	switch expr := expr.(type) {
+	case *ast.CompositeLit:
+		return expr
+	case *ast.UnaryExpr:
+		if expr.Op == token.AND {
+			return compositeLit(expr.X)
+		}
+	}
+
+	return nil
+}
+
+func typeName(expr ast.Expr) string {
+	if v, ok := expr.(*ast.SelectorExpr); ok {
+		if x, ok := v.X.(*ast.Ident); ok {
+			return x.Name + "." + v.Sel.Name
+		}
+	}
+
+	return ""
+}
+
+func keyName(expr ast.Expr) string {
+	if v, ok := expr.(*ast.Ident); ok {
+		return v.Name
+	}
+
+	return ""
+}
+
+// schemaValueType returns the value type, e.g. "String", of a schema.TypeString expression.
+func schemaValueType(expr ast.Expr) string {
+	switch v := typeName(expr); v {
+	case "schema.TypeBool", "schema.TypeInt", "schema.TypeFloat", "schema.TypeString", "schema.TypeList", "schema.TypeSet", "schema.TypeMap":
+		return strings.TrimPrefix(v, "schema.Type")
+	}
+
+	return ""
+}
+
+func isTrue(expr ast.Expr) bool {
+	v, ok := expr.(*ast.Ident)
+	return ok && v.Name == "true"
+}
+
+func intValue(expr ast.Expr) (int, error) {
+	if v, ok := expr.(*ast.BasicLit); ok && v.Kind == token.INT {
+		return strconv.Atoi(v.Value)
+	}
+
+	return 0, fmt.Errorf("expected an integer literal")
+}